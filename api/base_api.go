@@ -13,6 +13,7 @@ import (
 	"github.com/idena-network/idena-go/keystore"
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/secstore"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 )
 
@@ -41,6 +42,27 @@ func (api *BaseApi) getReadonlyAppState() *appstate.AppState {
 	return state
 }
 
+// getReadonlyAppStateAt returns readonly app state of the given block or of the head block if the number is not specified
+func (api *BaseApi) getReadonlyAppStateAt(blockNumber *uint64) (*appstate.AppState, error) {
+	if blockNumber == nil {
+		return api.engine.ReadonlyAppState()
+	}
+	return api.engine.ReadonlyAppStateAt(*blockNumber)
+}
+
+// getReadonlyAppStateWithHeader returns readonly app state of the given block along with the block header
+func (api *BaseApi) getReadonlyAppStateWithHeader(bc *blockchain.Blockchain, height uint64) (*appstate.AppState, *types.Header, error) {
+	header := bc.GetBlockHeaderByHeight(height)
+	if header == nil {
+		return nil, nil, errors.Errorf("block %v is not found", height)
+	}
+	appState, err := api.getReadonlyAppStateAt(&height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return appState, header, nil
+}
+
 func (api *BaseApi) getAppStateForCheck() *appstate.AppState {
 	state, err := api.engine.AppStateForCheck()
 	if err != nil {
@@ -5,9 +5,11 @@ import (
 	"github.com/idena-network/idena-go/blockchain"
 	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/hexutil"
 	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/keywords"
 	"github.com/idena-network/idena-go/protocol"
@@ -16,11 +18,32 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"math"
 	"math/big"
 	"sort"
+	"sync"
 )
 
+const (
+	maxSimulatedTxs     = 100
+	maxFeeHistoryBlocks = 128
+	suggestFeeBlocks    = 20
+)
+
+type feeUrgency struct {
+	// blocks is the number of full blocks the max fee should cover, fee per gas grows after each of them
+	blocks         int
+	tipsPercentile float64
+}
+
 var (
+	feeHistoryTipsPercentiles = []float64{10, 25, 50, 75, 90}
+	feeUrgencyParams          = map[string]feeUrgency{
+		"low":    {blocks: 1, tipsPercentile: 10},
+		"normal": {blocks: 3, tipsPercentile: 50},
+		"high":   {blocks: 10, tipsPercentile: 90},
+	}
+
 	txTypeMap = map[types.TxType]string{
 		types.SendTx:               "send",
 		types.ActivationTx:         "activation",
@@ -49,16 +72,17 @@ var (
 )
 
 type BlockchainApi struct {
-	bc      *blockchain.Blockchain
-	baseApi *BaseApi
-	ipfs    ipfs.Proxy
-	pool    *mempool.TxPool
-	d       *protocol.Downloader
-	pm      *protocol.IdenaGossipHandler
+	bc         *blockchain.Blockchain
+	baseApi    *BaseApi
+	ipfs       ipfs.Proxy
+	pool       *mempool.TxPool
+	d          *protocol.Downloader
+	pm         *protocol.IdenaGossipHandler
+	feeHistory *feeHistoryCache
 }
 
 func NewBlockchainApi(baseApi *BaseApi, bc *blockchain.Blockchain, ipfs ipfs.Proxy, pool *mempool.TxPool, d *protocol.Downloader, pm *protocol.IdenaGossipHandler) *BlockchainApi {
-	return &BlockchainApi{bc, baseApi, ipfs, pool, d, pm}
+	return &BlockchainApi{bc, baseApi, ipfs, pool, d, pm, newFeeHistoryCache()}
 }
 
 type Block struct {
@@ -104,6 +128,29 @@ type EstimateRawTxResponse struct {
 	TxFee   decimal.Decimal `json:"txFee"`
 }
 
+type SimulatedTx struct {
+	TxHash    common.Hash      `json:"txHash"`
+	Success   bool             `json:"success"`
+	Error     string           `json:"error"`
+	UsedFee   decimal.Decimal  `json:"usedFee"`
+	Receipt   *TxReceipt       `json:"receipt"`
+	Events    []*Event         `json:"events"`
+	Deltas    []*BalanceDelta  `json:"deltas"`
+	StateDiff []*StateDiffItem `json:"stateDiff"`
+}
+
+type BalanceDelta struct {
+	Address common.Address  `json:"address"`
+	Balance decimal.Decimal `json:"balance"`
+	Stake   decimal.Decimal `json:"stake"`
+}
+
+type StateDiffItem struct {
+	Key     hexutil.Bytes `json:"key"`
+	Value   hexutil.Bytes `json:"value"`
+	Deleted bool          `json:"deleted"`
+}
+
 func (api *BlockchainApi) LastBlock() *Block {
 	return api.BlockAt(api.bc.Head.Height())
 }
@@ -265,18 +312,270 @@ func (api *BlockchainApi) FeePerGas() *big.Int {
 	return api.baseApi.getReadonlyAppState().State.FeePerGas()
 }
 
+type FeeHistory struct {
+	Blocks          []*FeeHistoryBlock `json:"blocks"`
+	NextFeePerGas   *big.Int           `json:"nextFeePerGas"`
+	MaxBlockGas     uint64             `json:"maxBlockGas"`
+	TipsPercentiles []float64          `json:"tipsPercentiles"`
+}
+
+type FeeHistoryBlock struct {
+	Height       uint64            `json:"height"`
+	FeePerGas    *big.Int          `json:"feePerGas"`
+	GasUsed      uint64            `json:"gasUsed"`
+	GasUsedRatio float64           `json:"gasUsedRatio"`
+	TxCount      int               `json:"txCount"`
+	Tips         []decimal.Decimal `json:"tips"`
+}
+
+type SuggestedFee struct {
+	FeePerGas *big.Int        `json:"feePerGas"`
+	TxFee     decimal.Decimal `json:"txFee"`
+	MaxFee    decimal.Decimal `json:"maxFee"`
+	Tips      decimal.Decimal `json:"tips"`
+}
+
+// FeeHistory returns fee per gas, used gas and tips percentiles of the last blocks
+func (api *BlockchainApi) FeeHistory(blockCount uint64) (*FeeHistory, error) {
+	if blockCount == 0 || blockCount > maxFeeHistoryBlocks {
+		return nil, errors.Errorf("block count should be between 1 and %v", maxFeeHistoryBlocks)
+	}
+	entries, err := api.feeHistoryEntries(blockCount)
+	if err != nil {
+		return nil, err
+	}
+	res := &FeeHistory{
+		NextFeePerGas:   api.FeePerGas(),
+		MaxBlockGas:     types.MaxBlockGas,
+		TipsPercentiles: feeHistoryTipsPercentiles,
+	}
+	for _, entry := range entries {
+		res.Blocks = append(res.Blocks, entry.block)
+	}
+	return res, nil
+}
+
+// SuggestFee returns max fee covering fee per gas growth during the urgency dependent number of full blocks
+// and tips based on tips of recent blocks. Urgency is one of low, normal and high, normal is used by default.
+// Gas used by contracts is not taken into account.
+func (api *BlockchainApi) SuggestFee(txType types.TxType, payloadSize uint32, urgency *string) (*SuggestedFee, error) {
+	feeUrgency := "normal"
+	if urgency != nil {
+		feeUrgency = *urgency
+	}
+	params, ok := feeUrgencyParams[feeUrgency]
+	if !ok {
+		return nil, errors.Errorf("unknown urgency %v", feeUrgency)
+	}
+	if payloadSize > validation.MaxPayloadSize {
+		return nil, errors.Errorf("payload size should be less than %v", validation.MaxPayloadSize)
+	}
+	appState := api.baseApi.getReadonlyAppState()
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	feePerGas := appState.State.FeePerGas()
+
+	projectedFeePerGas := feePerGas
+	for i := 0; i < params.blocks; i++ {
+		projectedFeePerGas = api.bc.CalculateNextBlockFeePerGas(networkSize, projectedFeePerGas, types.MaxBlockGas)
+	}
+
+	tx := &types.Transaction{
+		Type:    txType,
+		To:      &common.Address{},
+		Payload: make([]byte, payloadSize),
+	}
+
+	entries, err := api.feeHistoryEntries(suggestFeeBlocks)
+	if err != nil {
+		return nil, err
+	}
+	var tips []*big.Int
+	for _, entry := range entries {
+		tips = append(tips, entry.tips...)
+	}
+
+	return &SuggestedFee{
+		FeePerGas: feePerGas,
+		TxFee:     blockchain.ConvertToFloat(fee.CalculateFee(networkSize, feePerGas, tx)),
+		MaxFee:    blockchain.ConvertToFloat(fee.CalculateFee(networkSize, projectedFeePerGas, tx)),
+		Tips:      tipsPercentiles(tips, []float64{params.tipsPercentile})[0],
+	}, nil
+}
+
+type feeHistoryEntry struct {
+	block *FeeHistoryBlock
+	tips  []*big.Int
+}
+
+// feeHistoryCache keeps fee history entries of the last maxFeeHistoryBlocks blocks by block hash,
+// so only blocks added since the previous request are loaded
+type feeHistoryCache struct {
+	mutex   sync.Mutex
+	entries map[common.Hash]*feeHistoryEntry
+}
+
+func newFeeHistoryCache() *feeHistoryCache {
+	return &feeHistoryCache{entries: make(map[common.Hash]*feeHistoryEntry)}
+}
+
+// feeHistoryEntries returns entries of the last blockCount blocks in ascending order
+func (api *BlockchainApi) feeHistoryEntries(blockCount uint64) ([]*feeHistoryEntry, error) {
+	head := api.bc.Head.Height()
+	from := uint64(1)
+	if head > blockCount {
+		from = head - blockCount + 1
+	}
+	cache := api.feeHistory
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	var res []*feeHistoryEntry
+	for height := from; height <= head; height++ {
+		header := api.bc.GetBlockHeaderByHeight(height)
+		if header == nil {
+			return nil, errors.Errorf("block %v is not found", height)
+		}
+		entry, ok := cache.entries[header.Hash()]
+		if !ok {
+			var err error
+			if entry, err = api.newFeeHistoryEntry(header); err != nil {
+				return nil, err
+			}
+			cache.entries[header.Hash()] = entry
+		}
+		res = append(res, entry)
+	}
+	for hash, entry := range cache.entries {
+		if entry.block.Height+maxFeeHistoryBlocks <= head {
+			delete(cache.entries, hash)
+		}
+	}
+	return res, nil
+}
+
+func (api *BlockchainApi) newFeeHistoryEntry(header *types.Header) (*feeHistoryEntry, error) {
+	block := api.bc.GetBlock(header.Hash())
+	if block == nil {
+		return nil, errors.Errorf("block %v is not found", header.Height())
+	}
+	usedGas, err := api.bc.BlockUsedGas(block)
+	if err != nil {
+		return nil, err
+	}
+	var tips []*big.Int
+	for _, tx := range block.Body.Transactions {
+		tips = append(tips, tx.TipsOrZero())
+	}
+	return &feeHistoryEntry{
+		block: &FeeHistoryBlock{
+			Height:       header.Height(),
+			FeePerGas:    header.FeePerGas(),
+			GasUsed:      usedGas,
+			GasUsedRatio: float64(usedGas) / float64(types.MaxBlockGas),
+			TxCount:      len(block.Body.Transactions),
+			Tips:         tipsPercentiles(tips, feeHistoryTipsPercentiles),
+		},
+		tips: tips,
+	}, nil
+}
+
+func tipsPercentiles(tips []*big.Int, percentiles []float64) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(percentiles))
+	if len(tips) == 0 {
+		return res
+	}
+	sorted := make([]*big.Int, len(tips))
+	copy(sorted, tips)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+	for i, p := range percentiles {
+		idx := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		if idx < 0 {
+			idx = 0
+		}
+		if idx >= len(sorted) {
+			idx = len(sorted) - 1
+		}
+		res[i] = blockchain.ConvertToFloat(sorted[idx])
+	}
+	return res
+}
+
 func (api *BlockchainApi) SendRawTx(ctx context.Context, bytesTx hexutil.Bytes) (common.Hash, error) {
-	var tx types.Transaction
+	tx, err := decodeRawTx(bytesTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return api.baseApi.sendInternalTx(ctx, tx)
+}
+
+// decodeRawTx decodes protobuf encoded transaction falling back to rlp
+func decodeRawTx(bytesTx []byte) (*types.Transaction, error) {
+	tx := new(types.Transaction)
 	if err := tx.FromBytes(bytesTx); err != nil {
 		//TODO: remove later
-		if err := rlp.DecodeBytes(bytesTx, &tx); err != nil {
-			return common.Hash{}, err
-		} else {
-			tx.UseRlp = true
+		if err := rlp.DecodeBytes(bytesTx, tx); err != nil {
+			return nil, err
 		}
+		tx.UseRlp = true
+	}
+	return tx, nil
+}
+
+// CancelTx replaces the pending tx with a zero send to its sender having the same nonce and min allowed replacement fee
+func (api *BlockchainApi) CancelTx(ctx context.Context, hash common.Hash) (common.Hash, error) {
+	tx, sender, err := api.replaceableTx(hash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	cancelTx := &types.Transaction{
+		AccountNonce: tx.AccountNonce,
+		Epoch:        tx.Epoch,
+		Type:         types.SendTx,
+		To:           &sender,
+		MaxFee:       api.pool.MinReplacementPrice(tx),
+	}
+	return api.sendReplacementTx(ctx, sender, cancelTx)
+}
+
+// SpeedUpTx replaces the pending tx with the same tx having the new max fee
+func (api *BlockchainApi) SpeedUpTx(ctx context.Context, hash common.Hash, maxFee decimal.Decimal) (common.Hash, error) {
+	tx, sender, err := api.replaceableTx(hash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	speedUpTx := &types.Transaction{
+		AccountNonce: tx.AccountNonce,
+		Epoch:        tx.Epoch,
+		Type:         tx.Type,
+		To:           tx.To,
+		Amount:       tx.Amount,
+		MaxFee:       blockchain.ConvertToInt(maxFee),
+		Tips:         tx.Tips,
+		Payload:      tx.Payload,
+	}
+	return api.sendReplacementTx(ctx, sender, speedUpTx)
+}
+
+func (api *BlockchainApi) replaceableTx(hash common.Hash) (*types.Transaction, common.Address, error) {
+	tx := api.pool.GetTx(hash)
+	if tx == nil {
+		return nil, common.Address{}, errors.New("transaction is not found in mempool")
 	}
+	sender, _ := types.Sender(tx)
+	if !api.baseApi.canSign(sender) {
+		return nil, common.Address{}, errors.Errorf("key of sender %v is not available", sender.Hex())
+	}
+	return tx, sender, nil
+}
 
-	return api.baseApi.sendInternalTx(ctx, &tx)
+func (api *BlockchainApi) sendReplacementTx(ctx context.Context, sender common.Address, tx *types.Transaction) (common.Hash, error) {
+	signedTx, err := api.baseApi.signTransaction(sender, tx, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return api.baseApi.sendInternalTx(ctx, signedTx)
 }
 
 func (api *BlockchainApi) GetRawTx(args SendTxArgs) (hexutil.Bytes, error) {
@@ -349,6 +648,85 @@ func (api *BlockchainApi) EstimateRawTx(bytesTx hexutil.Bytes, from *common.Addr
 	return response, nil
 }
 
+// SimulateTxs applies signed transactions one after another on a copy of the state of the given block or of the head block
+// and returns receipts, events, balance deltas and state changes of each transaction, nothing is broadcast or saved
+func (api *BlockchainApi) SimulateTxs(bytesTxs []hexutil.Bytes, height *uint64) ([]*SimulatedTx, error) {
+	if len(bytesTxs) == 0 {
+		return nil, errors.New("no transactions to simulate")
+	}
+	if len(bytesTxs) > maxSimulatedTxs {
+		return nil, errors.Errorf("too many transactions, max %v", maxSimulatedTxs)
+	}
+	var txs []*types.Transaction
+	for i, bytesTx := range bytesTxs {
+		tx, err := decodeRawTx(bytesTx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse transaction %v", i)
+		}
+		if !tx.Signed() {
+			return nil, errors.Errorf("transaction %v is not signed", i)
+		}
+		txs = append(txs, tx)
+	}
+	blockHeight := api.bc.Head.Height()
+	if height != nil {
+		blockHeight = *height
+	}
+	simulated, feePerGas, err := api.bc.SimulateTxs(blockHeight, txs)
+	if err != nil {
+		return nil, err
+	}
+	var result []*SimulatedTx
+	for _, item := range simulated {
+		result = append(result, convertSimulatedTx(item, feePerGas))
+	}
+	return result, nil
+}
+
+func convertSimulatedTx(simulated *blockchain.SimulatedTx, feePerGas *big.Int) *SimulatedTx {
+	res := &SimulatedTx{
+		TxHash:  simulated.Tx.Hash(),
+		Success: simulated.Err == nil,
+		UsedFee: blockchain.ConvertToFloat(simulated.Fee),
+	}
+	if simulated.Err != nil {
+		res.Error = simulated.Err.Error()
+		return res
+	}
+	if simulated.Receipt != nil {
+		res.Receipt = convertReceipt(simulated.Tx, simulated.Receipt, feePerGas)
+		if !simulated.Receipt.Success {
+			res.Success = false
+			res.Error = res.Receipt.Error
+		}
+		for _, txEvent := range simulated.Receipt.Events {
+			e := &Event{
+				Contract: txEvent.Contract,
+				Event:    txEvent.EventName,
+			}
+			for _, arg := range txEvent.Data {
+				e.Args = append(e.Args, arg)
+			}
+			res.Events = append(res.Events, e)
+		}
+	}
+	for _, delta := range simulated.Deltas {
+		res.Deltas = append(res.Deltas, &BalanceDelta{
+			Address: delta.Address,
+			Balance: blockchain.ConvertToFloat(delta.Balance),
+			Stake:   blockchain.ConvertToFloat(delta.Stake),
+		})
+	}
+	for _, item := range simulated.StateDiff {
+		res.StateDiff = append(res.StateDiff, &StateDiffItem{
+			Key:     item.Key,
+			Value:   item.Value,
+			Deleted: item.Deleted,
+		})
+	}
+	return res
+}
+
 func (api *BlockchainApi) EstimateTx(args SendTxArgs) (*EstimateTxResponse, error) {
 	var payload []byte
 	if args.Payload != nil {
@@ -403,6 +781,82 @@ func (api *BlockchainApi) BurntCoins() []BurntCoins {
 	return res
 }
 
+type StateProof struct {
+	Height uint64        `json:"height"`
+	Root   common.Hash   `json:"root"`
+	Key    hexutil.Bytes `json:"key"`
+	Value  hexutil.Bytes `json:"value"`
+	Proof  hexutil.Bytes `json:"proof"`
+}
+
+type IdentityProof struct {
+	Identity *StateProof `json:"identity"`
+	// ApprovedIdentity is a proof against the identity root, it is nil if the identity is not approved.
+	// Light nodes return an error instead since they can't prove the absence of the identity
+	ApprovedIdentity *StateProof `json:"approvedIdentity"`
+}
+
+// GetAccountProof returns the account state with the proof verifiable against the state root of the block
+func (api *BlockchainApi) GetAccountProof(address common.Address, height uint64) (*StateProof, error) {
+	appState, header, err := api.baseApi.getReadonlyAppStateWithHeader(api.bc, height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := appState.State.GetAccountWithProof(address)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, errors.New("account not found")
+	}
+	return newStateProof(height, header.Root(), state.StateDbKeys.AddressKey(address), proof)
+}
+
+// GetIdentityProof returns the identity state with the proof verifiable against the state root of the block
+// and the approved identity state with the proof verifiable against the identity root of the block
+func (api *BlockchainApi) GetIdentityProof(address common.Address, height uint64) (*IdentityProof, error) {
+	appState, header, err := api.baseApi.getReadonlyAppStateWithHeader(api.bc, height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := appState.State.GetIdentityWithProof(address)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, errors.New("identity not found")
+	}
+	res := &IdentityProof{}
+	if res.Identity, err = newStateProof(height, header.Root(), state.StateDbKeys.IdentityKey(address), proof); err != nil {
+		return nil, err
+	}
+	proof, err = appState.IdentityState.GetIdentityWithProof(address)
+	if err != nil {
+		return nil, err
+	}
+	if proof != nil {
+		if res.ApprovedIdentity, err = newStateProof(height, header.IdentityRoot(), state.StateDbKeys.IdentityKey(address), proof); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// newStateProof verifies the proof before returning it to make sure the node doesn't serve inconsistent data
+func newStateProof(height uint64, root common.Hash, key []byte, proof []byte) (*StateProof, error) {
+	value, err := state.VerifyValueWithProof(root, key, proof)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build proof")
+	}
+	return &StateProof{
+		Height: height,
+		Root:   root,
+		Key:    key,
+		Value:  value,
+		Proof:  proof,
+	}, nil
+}
+
 func convertToTransaction(tx *types.Transaction, blockHash common.Hash, feePerGas *big.Int, timestamp int64) *Transaction {
 	sender, _ := types.Sender(tx)
 	return &Transaction{
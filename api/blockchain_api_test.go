@@ -0,0 +1,100 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func Test_decodeRawTx(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.Address{0x1}
+
+	for _, useRlp := range []bool{false, true} {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: 1,
+			Type:         types.SendTx,
+			To:           &to,
+			Amount:       big.NewInt(10),
+			MaxFee:       big.NewInt(1),
+			UseRlp:       useRlp,
+		}, key)
+		var data []byte
+		var err error
+		if useRlp {
+			data, err = rlp.EncodeToBytes(tx)
+		} else {
+			data, err = tx.ToBytes()
+		}
+		require.NoError(t, err)
+
+		decoded, err := decodeRawTx(data)
+		require.NoError(t, err)
+		require.Equal(t, useRlp, decoded.UseRlp)
+		require.Equal(t, tx.Hash(), decoded.Hash())
+		sender, err := types.Sender(decoded)
+		require.NoError(t, err)
+		require.Equal(t, addr, sender)
+	}
+
+	_, err := decodeRawTx([]byte{0x1, 0x2, 0x3})
+	require.Error(t, err)
+}
+
+func Test_tipsPercentiles(t *testing.T) {
+	percentiles := []float64{0, 10, 50, 90, 100}
+
+	empty := tipsPercentiles(nil, percentiles)
+	require.Len(t, empty, len(percentiles))
+	for _, value := range empty {
+		require.True(t, value.IsZero())
+	}
+
+	tip := blockchain.ConvertToFloat(big.NewInt(7))
+	require.Equal(t, []decimal.Decimal{tip, tip, tip, tip, tip}, tipsPercentiles([]*big.Int{big.NewInt(7)}, percentiles))
+
+	var tips []*big.Int
+	for i := int64(10); i > 0; i-- {
+		tips = append(tips, big.NewInt(i))
+	}
+	var expected []decimal.Decimal
+	for _, value := range []int64{1, 1, 5, 9, 10} {
+		expected = append(expected, blockchain.ConvertToFloat(big.NewInt(value)))
+	}
+	require.Equal(t, expected, tipsPercentiles(tips, percentiles))
+	require.Equal(t, big.NewInt(10), tips[0], "tips should not be reordered")
+}
+
+func TestBlockchainApi_feeHistoryEntries(t *testing.T) {
+	chain, _ := blockchain.NewTestBlockchainWithBlocks(3, 0)
+	chain.GenerateBlocks(2, 2)
+	api := &BlockchainApi{bc: chain.Blockchain, feeHistory: newFeeHistoryCache()}
+
+	entries, err := api.feeHistoryEntries(3)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, chain.Head.Height(), entries[2].block.Height)
+	require.Len(t, entries[2].tips, 2)
+	require.Equal(t, 2, entries[2].block.TxCount)
+	require.NotZero(t, entries[2].block.GasUsed)
+	require.Len(t, api.feeHistory.entries, 3)
+
+	cached, err := api.feeHistoryEntries(2)
+	require.NoError(t, err)
+	require.Same(t, entries[1], cached[0])
+	require.Same(t, entries[2], cached[1])
+
+	chain.GenerateBlocks(maxFeeHistoryBlocks, 0)
+	entries, err = api.feeHistoryEntries(maxFeeHistoryBlocks)
+	require.NoError(t, err)
+	require.Len(t, entries, maxFeeHistoryBlocks)
+	require.Len(t, api.feeHistory.entries, maxFeeHistoryBlocks)
+	require.Equal(t, chain.Head.Height()-maxFeeHistoryBlocks+1, entries[0].block.Height)
+}
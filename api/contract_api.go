@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/binary"
 	"github.com/idena-network/idena-go/blockchain"
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/fee"
@@ -9,9 +10,11 @@ import (
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/deferredtx"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/vm/embedded"
 	"github.com/idena-network/idena-go/vm/env"
 	"github.com/idena-network/idena-go/vm/helpers"
 	"github.com/pkg/errors"
@@ -66,16 +69,28 @@ type DynamicArg struct {
 }
 
 type ReadonlyCallArgs struct {
-	Contract common.Address `json:"contract"`
-	Method   string         `json:"method"`
-	Format   string         `json:"format"`
-	Args     DynamicArgs    `json:"args"`
+	Contract    common.Address `json:"contract"`
+	Method      string         `json:"method"`
+	Format      string         `json:"format"`
+	Args        DynamicArgs    `json:"args"`
+	BlockNumber *uint64        `json:"blockNumber"`
 }
 
 type EventsArgs struct {
 	Contract common.Address `json:"contract"`
 }
 
+type GetLogsArgs struct {
+	FromBlock uint64           `json:"fromBlock"`
+	ToBlock   *uint64          `json:"toBlock"`
+	Contracts []common.Address `json:"contracts"`
+	Events    []string         `json:"events"`
+	// ArgFilters match event args by index, args with the same index are alternatives
+	ArgFilters        DynamicArgs    `json:"argFilters"`
+	Limit             int            `json:"limit"`
+	ContinuationToken *hexutil.Bytes `json:"continuationToken"`
+}
+
 type KeyWithFormat struct {
 	Key    string `json:"key"`
 	Format string `json:"format"`
@@ -89,58 +104,56 @@ type ContractData struct {
 
 func (a DynamicArg) ToBytes() ([]byte, error) {
 	switch a.Format {
-	case "byte":
-		i, err := strconv.ParseUint(a.Value, 10, 8)
-		if err != nil {
-			return nil, errors.Errorf("cannot parse byte: \"%v\"", a.Value)
-		}
-		return []byte{byte(i)}, nil
 	case "int8":
 		i, err := strconv.ParseInt(a.Value, 10, 8)
 		if err != nil {
 			return nil, errors.Errorf("cannot parse int8: \"%v\"", a.Value)
 		}
 		return common.ToBytes(i), nil
-	case "uint64":
-		i, err := strconv.ParseUint(a.Value, 10, 64)
-		if err != nil {
-			return nil, errors.Errorf("cannot parse uint64: \"%v\"", a.Value)
-		}
-		return common.ToBytes(i), nil
 	case "int64":
 		i, err := strconv.ParseInt(a.Value, 10, 64)
 		if err != nil {
 			return nil, errors.Errorf("cannot parse int64: \"%v\"", a.Value)
 		}
 		return common.ToBytes(i), nil
-	case "string":
-		return []byte(a.Value), nil
-	case "bigint":
-		v := new(big.Int)
-		_, ok := v.SetString(a.Value, 10)
-		if !ok {
-			return nil, errors.Errorf("cannot parse bigint: \"%v\"", a.Value)
-		}
-		return v.Bytes(), nil
-	case "hex":
-		data, err := hexutil.Decode(a.Value)
-		if err != nil {
-			return nil, errors.Errorf("cannot parse hex: \"%v\"", a.Value)
+	default:
+		return embedded.ConvertArg(a.Format, a.Value)
+	}
+}
+
+// ToSliceWithDescriptor validates args against the contract args descriptor and converts them,
+// args without format are converted using the described format
+func (d DynamicArgs) ToSliceWithDescriptor(descriptor []embedded.ArgDescriptor) ([][]byte, error) {
+	var args DynamicArgs
+	present := make(map[int]struct{})
+	for _, a := range d {
+		if a.Index < 0 || a.Index >= len(descriptor) {
+			return nil, errors.Errorf("unexpected argument with index %v", a.Index)
 		}
-		return data, nil
-	case "dna":
-		d, err := decimal.NewFromString(a.Value)
-		if err != nil {
-			return nil, errors.Errorf("cannot parse dna: \"%v\"", a.Value)
+		expected := descriptor[a.Index]
+		arg := *a
+		if len(arg.Format) == 0 {
+			arg.Format = expected.Format
+		} else if !compatibleArgFormats(arg.Format, expected.Format) {
+			return nil, errors.Errorf("argument %v (%v) should have %v format", a.Index, expected.Name, expected.Format)
 		}
-		return blockchain.ConvertToInt(d).Bytes(), nil
-	default:
-		data, err := hexutil.Decode(a.Value)
-		if err != nil {
-			return nil, errors.Errorf("cannot parse hex: \"%v\"", a.Value)
+		args = append(args, &arg)
+		present[a.Index] = struct{}{}
+	}
+	for i, expected := range descriptor {
+		if _, ok := present[i]; !ok && !expected.Optional {
+			return nil, errors.Errorf("argument %v (%v) is required", i, expected.Name)
 		}
-		return data, nil
 	}
+	return args.ToSlice()
+}
+
+func compatibleArgFormats(format, expected string) bool {
+	if format == expected || format == embedded.HexFormat {
+		return true
+	}
+	bigIntFormats := map[string]bool{embedded.BigIntFormat: true, embedded.DnaFormat: true}
+	return bigIntFormats[format] && bigIntFormats[expected]
 }
 
 func (d DynamicArgs) ToSlice() ([][]byte, error) {
@@ -180,12 +193,46 @@ type TxReceipt struct {
 	TxFee    decimal.Decimal `json:"txFee"`
 }
 
+type ContractTrace struct {
+	Receipt *TxReceipt `json:"receipt"`
+	Ops     []*TraceOp `json:"ops"`
+	Panic   string     `json:"panic,omitempty"`
+}
+
+type TraceOp struct {
+	Op       string           `json:"op"`
+	Contract common.Address   `json:"contract"`
+	Key      hexutil.Bytes    `json:"key,omitempty"`
+	Value    hexutil.Bytes    `json:"value,omitempty"`
+	Dest     *common.Address  `json:"dest,omitempty"`
+	Amount   *decimal.Decimal `json:"amount,omitempty"`
+	Event    string           `json:"event,omitempty"`
+	Method   string           `json:"method,omitempty"`
+	Args     []hexutil.Bytes  `json:"args,omitempty"`
+	Gas      int              `json:"gas"`
+}
+
 type Event struct {
 	Contract common.Address  `json:"contract"`
 	Event    string          `json:"event"`
 	Args     []hexutil.Bytes `json:"args"`
 }
 
+type Log struct {
+	Contract    common.Address  `json:"contract"`
+	Event       string          `json:"event"`
+	Args        []hexutil.Bytes `json:"args"`
+	TxHash      common.Hash     `json:"txHash"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	BlockHeight uint64          `json:"blockHeight"`
+	Index       uint32          `json:"index"`
+}
+
+type GetLogsResponse struct {
+	Logs              []*Log         `json:"logs"`
+	ContinuationToken *hexutil.Bytes `json:"continuationToken"`
+}
+
 type MapItem struct {
 	Key   interface{} `json:"key"`
 	Value interface{} `json:"value"`
@@ -204,7 +251,13 @@ func (api *ContractApi) buildDeployContractTx(args DeployArgs, estimate bool) (*
 	if from == (common.Address{}) {
 		from = api.baseApi.getCurrentCoinbase()
 	}
-	convertedArgs, err := args.Args.ToSlice()
+	var convertedArgs [][]byte
+	var err error
+	if descriptor, ok := embedded.Descriptors[codeHash]; ok {
+		convertedArgs, err = args.Args.ToSliceWithDescriptor(descriptor.Deploy)
+	} else {
+		convertedArgs, err = args.Args.ToSlice()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +272,17 @@ func (api *ContractApi) buildCallContractTx(args CallArgs, estimate bool) (*type
 	if from == (common.Address{}) {
 		from = api.baseApi.getCurrentCoinbase()
 	}
-	convertedArgs, err := args.Args.ToSlice()
+	var convertedArgs [][]byte
+	var err error
+	if descriptor := api.contractDescriptor(args.Contract); descriptor != nil {
+		method, ok := descriptor.CallMethod(args.Method)
+		if !ok {
+			return nil, errors.Errorf("unknown method %v", args.Method)
+		}
+		convertedArgs, err = args.Args.ToSliceWithDescriptor(method.Args)
+	} else {
+		convertedArgs, err = args.Args.ToSlice()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +298,13 @@ func (api *ContractApi) buildTerminateContractTx(args TerminateArgs, estimate bo
 	if from == (common.Address{}) {
 		from = api.baseApi.getCurrentCoinbase()
 	}
-	convertedArgs, err := args.Args.ToSlice()
+	var convertedArgs [][]byte
+	var err error
+	if descriptor := api.contractDescriptor(args.Contract); descriptor != nil {
+		convertedArgs, err = args.Args.ToSliceWithDescriptor(descriptor.Terminate)
+	} else {
+		convertedArgs, err = args.Args.ToSlice()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -245,6 +314,15 @@ func (api *ContractApi) buildTerminateContractTx(args TerminateArgs, estimate bo
 	return api.signIfNeeded(from, tx, estimate)
 }
 
+// contractDescriptor returns the descriptor of the deployed embedded contract or nil if the contract is unknown
+func (api *ContractApi) contractDescriptor(contract common.Address) *embedded.ContractDescriptor {
+	codeHash := api.baseApi.getReadonlyAppState().State.GetCodeHash(contract)
+	if codeHash == nil {
+		return nil
+	}
+	return embedded.Descriptors[*codeHash]
+}
+
 func (api *ContractApi) signIfNeeded(from common.Address, tx *types.Transaction, estimate bool) (*types.Transaction, error) {
 	sign := !estimate || api.baseApi.canSign(from)
 	if !sign {
@@ -274,8 +352,18 @@ func (api *ContractApi) EstimateDeploy(args DeployArgs) (*TxReceipt, error) {
 }
 
 func (api *ContractApi) EstimateCall(args CallArgs) (*TxReceipt, error) {
+	return api.runCall(args, nil)
+}
+
+// runCall executes the call on the state copy, env operations are recorded if trace is not nil
+func (api *ContractApi) runCall(args CallArgs, trace *env.Trace) (*TxReceipt, error) {
 	appState := api.baseApi.getAppStateForCheck()
-	vm := vm.NewVmImpl(appState, api.bc.Head, nil, api.bc.Config())
+	var executor vm.VM
+	if trace != nil {
+		executor = vm.NewTracingVmImpl(appState, api.bc.Head, api.bc.Config(), trace)
+	} else {
+		executor = vm.NewVmImpl(appState, api.bc.Head, nil, api.bc.Config())
+	}
 	tx, err := api.buildCallContractTx(args, true)
 	if err != nil {
 		return nil, err
@@ -299,7 +387,7 @@ func (api *ContractApi) EstimateCall(args CallArgs) (*TxReceipt, error) {
 		appState.State.AddBalance(*tx.To, tx.Amount)
 	}
 
-	r := vm.Run(tx, from, -1)
+	r := executor.Run(tx, from, -1)
 	r.GasCost = api.bc.GetGasCost(appState, r.GasUsed)
 	return convertEstimatedReceipt(tx, r, appState.State.FeePerGas()), nil
 }
@@ -384,14 +472,34 @@ func (api *ContractApi) Terminate(ctx context.Context, args TerminateArgs) (comm
 	return api.baseApi.sendInternalTx(ctx, tx)
 }
 
-func (api *ContractApi) ReadData(contract common.Address, key string, format string) (interface{}, error) {
-	data := api.baseApi.getReadonlyAppState().State.GetContractValue(contract, []byte(key))
+func (api *ContractApi) ReadData(contract common.Address, key string, format string, blockNumber *uint64) (interface{}, error) {
+	appState, err := api.baseApi.getReadonlyAppStateAt(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	data := appState.State.GetContractValue(contract, []byte(key))
 	if data == nil {
 		return nil, errors.New("data is nil")
 	}
 	return conversion(format, data)
 }
 
+// GetStorageProof returns the contract storage value with the proof verifiable against the state root of the block
+func (api *ContractApi) GetStorageProof(contract common.Address, key hexutil.Bytes, height uint64) (*StateProof, error) {
+	appState, header, err := api.baseApi.getReadonlyAppStateWithHeader(api.bc, height)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := appState.State.GetContractValueWithProof(contract, key)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil {
+		return nil, errors.New("data is nil")
+	}
+	return newStateProof(height, header.Root(), state.StateDbKeys.ContractStoreKey(contract, key), proof)
+}
+
 func (api *ContractApi) BatchReadData(contract common.Address, keys []KeyWithFormat) []ContractData {
 	res := make([]ContractData, 0, len(keys))
 	for _, keyWithFormat := range keys {
@@ -413,8 +521,32 @@ func (api *ContractApi) BatchReadData(contract common.Address, keys []KeyWithFor
 }
 
 func (api *ContractApi) ReadonlyCall(args ReadonlyCallArgs) (interface{}, error) {
-	vm := vm.NewVmImpl(api.baseApi.getReadonlyAppState(), api.bc.Head, nil, api.bc.Config())
-	convertedArgs, err := args.Args.ToSlice()
+	appState, err := api.baseApi.getReadonlyAppStateAt(args.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	header := api.bc.Head
+	if args.BlockNumber != nil {
+		header = api.bc.GetBlockHeaderByHeight(*args.BlockNumber)
+		if header == nil {
+			return nil, errors.Errorf("block %v is not found", *args.BlockNumber)
+		}
+	}
+	vm := vm.NewVmImpl(appState, header, nil, api.bc.Config())
+	format := args.Format
+	var convertedArgs [][]byte
+	if codeHash := appState.State.GetCodeHash(args.Contract); codeHash != nil && embedded.Descriptors[*codeHash] != nil {
+		method, ok := embedded.Descriptors[*codeHash].ReadMethod(args.Method)
+		if !ok {
+			return nil, errors.Errorf("unknown method %v", args.Method)
+		}
+		if len(format) == 0 {
+			format = method.Result
+		}
+		convertedArgs, err = args.Args.ToSliceWithDescriptor(method.Args)
+	} else {
+		convertedArgs, err = args.Args.ToSlice()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +554,89 @@ func (api *ContractApi) ReadonlyCall(args ReadonlyCallArgs) (interface{}, error)
 	if err != nil {
 		return nil, err
 	}
-	return conversion(args.Format, data)
+	return conversion(format, data)
+}
+
+// TraceTx re-executes the contract transaction on the parent block state and returns env operations of the execution
+func (api *ContractApi) TraceTx(hash common.Hash) (*ContractTrace, error) {
+	traced, err := api.bc.TraceTx(hash)
+	if err != nil {
+		return nil, err
+	}
+	return convertTrace(convertReceipt(traced.Tx, traced.Receipt, traced.Header.FeePerGas()), traced.Trace), nil
+}
+
+// TraceCall executes the call on the current state like contract_estimateCall and returns env operations of the execution
+func (api *ContractApi) TraceCall(args CallArgs) (*ContractTrace, error) {
+	trace := new(env.Trace)
+	receipt, err := api.runCall(args, trace)
+	if err != nil {
+		return nil, err
+	}
+	return convertTrace(receipt, trace), nil
+}
+
+func convertTrace(receipt *TxReceipt, trace *env.Trace) *ContractTrace {
+	res := &ContractTrace{
+		Receipt: receipt,
+		Ops:     make([]*TraceOp, 0, len(trace.Ops)),
+		Panic:   trace.Panic,
+	}
+	for _, op := range trace.Ops {
+		item := &TraceOp{
+			Op:       op.Op,
+			Contract: op.Contract,
+			Key:      op.Key,
+			Value:    op.Value,
+			Dest:     op.Dest,
+			Event:    op.Event,
+			Method:   op.Method,
+			Gas:      op.Gas,
+		}
+		if op.Amount != nil {
+			amount := blockchain.ConvertToFloat(op.Amount)
+			item.Amount = &amount
+		}
+		for _, arg := range op.Args {
+			item.Args = append(item.Args, arg)
+		}
+		res.Ops = append(res.Ops, item)
+	}
+	return res
+}
+
+// Describe returns arguments, storage layout and events of the embedded contract with the given code hash
+func (api *ContractApi) Describe(codeHash hexutil.Bytes) (*embedded.ContractDescriptor, error) {
+	var hash common.Hash
+	hash.SetBytes(codeHash)
+	descriptor, ok := embedded.Descriptors[hash]
+	if !ok {
+		return nil, errors.New("unknown contract")
+	}
+	return descriptor, nil
+}
+
+type ContractImplementation struct {
+	CodeHash common.Hash `json:"codeHash"`
+	Name     string      `json:"name"`
+	Version  byte        `json:"version"`
+	// Recorded is false for contracts deployed before implementation versioning, their implementation follows consensus upgrades
+	Recorded bool `json:"recorded"`
+}
+
+// GetImplementation returns the implementation executing calls of the deployed contract
+func (api *ContractApi) GetImplementation(contract common.Address) (*ContractImplementation, error) {
+	appState := api.baseApi.getReadonlyAppState()
+	impl, recorded := vm.DeployedImplementation(appState, api.bc.Config().Consensus, contract)
+	if impl == nil {
+		return nil, errors.New("contract implementation is not found")
+	}
+	return &ContractImplementation{
+		CodeHash: impl.CodeHash,
+		Name:     impl.Name,
+		Version:  impl.Version,
+		Recorded: recorded,
+	}, nil
 }
 
 func (api *ContractApi) GetStake(contract common.Address) interface{} {
@@ -463,6 +677,72 @@ func (api *ContractApi) Events(args EventsArgs) interface{} {
 	return list
 }
 
+// GetLogs returns events of contracts from the block range without subscriptions, blocks without matching events are skipped by tx blooms
+func (api *ContractApi) GetLogs(args GetLogsArgs) (*GetLogsResponse, error) {
+	const (
+		defaultLimit = 100
+		maxLimit     = 1000
+	)
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		return nil, errors.Errorf("limit should not exceed %v", maxLimit)
+	}
+	from := blockchain.LogPosition{Height: args.FromBlock}
+	if args.ContinuationToken != nil && len(*args.ContinuationToken) > 0 {
+		token := *args.ContinuationToken
+		if len(token) != 12 {
+			return nil, errors.New("invalid continuation token")
+		}
+		from.Height = binary.LittleEndian.Uint64(token[:8])
+		from.Index = binary.LittleEndian.Uint32(token[8:])
+	}
+	to := api.bc.Head.Height()
+	if args.ToBlock != nil {
+		to = *args.ToBlock
+	}
+	filter := &blockchain.LogFilter{
+		Contracts: args.Contracts,
+		Events:    args.Events,
+		Args:      make(map[int][][]byte),
+	}
+	for _, a := range args.ArgFilters {
+		value, err := a.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		filter.Args[a.Index] = append(filter.Args[a.Index], value)
+	}
+	logs, next, err := api.bc.FilterLogs(filter, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := &GetLogsResponse{
+		Logs: make([]*Log, 0, len(logs)),
+	}
+	for _, l := range logs {
+		item := &Log{
+			Contract:    l.Contract,
+			Event:       l.Event,
+			TxHash:      l.TxHash,
+			BlockHash:   l.BlockHash,
+			BlockHeight: l.Height,
+			Index:       l.Index,
+		}
+		for _, arg := range l.Args {
+			item.Args = append(item.Args, arg)
+		}
+		res.Logs = append(res.Logs, item)
+	}
+	if next != nil {
+		token := hexutil.Bytes(append(common.ToBytes(next.Height), common.ToBytes(next.Index)...))
+		res.ContinuationToken = &token
+	}
+	return res, nil
+}
+
 func (api *ContractApi) ReadMap(contract common.Address, mapName string, key hexutil.Bytes, format string) (interface{}, error) {
 	data := api.baseApi.getReadonlyAppState().State.GetContractValue(contract, env.FormatMapKey([]byte(mapName), key))
 	if data == nil {
@@ -522,8 +802,12 @@ func conversion(convertTo string, data []byte) (interface{}, error) {
 		return helpers.ExtractByte(0, data)
 	case "uint64":
 		return helpers.ExtractUInt64(0, data)
+	case "uint16":
+		return helpers.ExtractUInt16(0, data)
 	case "string":
 		return string(data), nil
+	case "address":
+		return common.BytesToAddress(data), nil
 	case "bigint":
 		v := new(big.Int)
 		v.SetBytes(data)
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestDynamicArg_ToBytes(t *testing.T) {
+	addr := common.Address{0x1, 0x2}
+	cases := []struct {
+		arg      DynamicArg
+		expected []byte
+	}{
+		{DynamicArg{Format: "byte", Value: "255"}, []byte{255}},
+		{DynamicArg{Format: "uint16", Value: "65535"}, common.ToBytes(uint16(65535))},
+		{DynamicArg{Format: "int8", Value: "-1"}, common.ToBytes(int64(-1))},
+		{DynamicArg{Format: "uint64", Value: "1000"}, common.ToBytes(uint64(1000))},
+		{DynamicArg{Format: "int64", Value: "-1000"}, common.ToBytes(int64(-1000))},
+		{DynamicArg{Format: "string", Value: "abc"}, []byte("abc")},
+		{DynamicArg{Format: "address", Value: addr.Hex()}, addr.Bytes()},
+		{DynamicArg{Format: "bigint", Value: "123456789012345678901234567890"}, func() []byte {
+			v, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+			return v.Bytes()
+		}()},
+		{DynamicArg{Format: "hex", Value: "0x0102"}, []byte{0x1, 0x2}},
+		{DynamicArg{Format: "dna", Value: "1.5"}, blockchain.ConvertToInt(decimal.RequireFromString("1.5")).Bytes()},
+		{DynamicArg{Value: "0x03"}, []byte{0x3}},
+	}
+	for _, c := range cases {
+		data, err := c.arg.ToBytes()
+		require.NoError(t, err, c.arg.Format)
+		require.Equal(t, c.expected, data, c.arg.Format)
+	}
+
+	for _, arg := range []DynamicArg{
+		{Format: "byte", Value: "256"},
+		{Format: "uint16", Value: "65536"},
+		{Format: "int8", Value: "128"},
+		{Format: "uint64", Value: "-1"},
+		{Format: "int64", Value: "1.5"},
+		{Format: "address", Value: "0x01"},
+		{Format: "bigint", Value: "1e10"},
+		{Format: "hex", Value: "0102"},
+		{Format: "dna", Value: "one"},
+		{Value: "abc"},
+	} {
+		_, err := arg.ToBytes()
+		require.Error(t, err, arg.Format)
+	}
+}
+
+func TestDynamicArgs_ToSliceWithDescriptor(t *testing.T) {
+	addr := common.Address{0x1}
+	descriptor := []embedded.ArgDescriptor{
+		{Name: "dest", Format: embedded.AddressFormat},
+		{Name: "amount", Format: embedded.DnaFormat},
+		{Name: "data", Format: embedded.Uint64Format, Optional: true},
+	}
+
+	args, err := DynamicArgs{
+		{Index: 1, Value: "2"},
+		{Index: 0, Value: addr.Hex()},
+	}.ToSliceWithDescriptor(descriptor)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{addr.Bytes(), blockchain.ConvertToInt(decimal.New(2, 0)).Bytes()}, args)
+
+	args, err = DynamicArgs{
+		{Index: 0, Format: embedded.HexFormat, Value: "0x01"},
+		{Index: 1, Format: embedded.BigIntFormat, Value: "2"},
+		{Index: 2, Format: embedded.Uint64Format, Value: "3"},
+	}.ToSliceWithDescriptor(descriptor)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{0x1}, big.NewInt(2).Bytes(), common.ToBytes(uint64(3))}, args)
+
+	args, err = DynamicArgs{
+		{Index: 0, Value: addr.Hex()},
+		{Index: 1, Value: "2"},
+		{Index: 2, Value: "3"},
+	}.ToSliceWithDescriptor(descriptor[:2])
+	require.Error(t, err, "unexpected argument index")
+	require.Nil(t, args)
+
+	_, err = DynamicArgs{{Index: -1, Value: "0x01"}}.ToSliceWithDescriptor(descriptor)
+	require.Error(t, err, "negative argument index")
+
+	_, err = DynamicArgs{
+		{Index: 0, Format: embedded.Uint64Format, Value: "1"},
+		{Index: 1, Value: "2"},
+	}.ToSliceWithDescriptor(descriptor)
+	require.Error(t, err, "incompatible format")
+
+	_, err = DynamicArgs{
+		{Index: 0, Value: addr.Hex()},
+		{Index: 1, Format: embedded.Uint64Format, Value: "2"},
+	}.ToSliceWithDescriptor(descriptor)
+	require.Error(t, err, "uint64 is not compatible with dna")
+
+	_, err = DynamicArgs{{Index: 0, Value: addr.Hex()}}.ToSliceWithDescriptor(descriptor)
+	require.Error(t, err, "missing required argument")
+
+	_, err = DynamicArgs{
+		{Index: 0, Value: "0x01"},
+		{Index: 1, Value: "2"},
+	}.ToSliceWithDescriptor(descriptor)
+	require.Error(t, err, "invalid value of described format")
+}
+
+func Test_compatibleArgFormats(t *testing.T) {
+	require.True(t, compatibleArgFormats(embedded.AddressFormat, embedded.AddressFormat))
+	require.True(t, compatibleArgFormats(embedded.HexFormat, embedded.AddressFormat))
+	require.True(t, compatibleArgFormats(embedded.HexFormat, embedded.DnaFormat))
+	require.True(t, compatibleArgFormats(embedded.BigIntFormat, embedded.DnaFormat))
+	require.True(t, compatibleArgFormats(embedded.DnaFormat, embedded.BigIntFormat))
+	require.False(t, compatibleArgFormats(embedded.Uint64Format, embedded.DnaFormat))
+	require.False(t, compatibleArgFormats(embedded.AddressFormat, embedded.HexFormat))
+	require.False(t, compatibleArgFormats(embedded.DnaFormat, embedded.Uint64Format))
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"math/big"
 	"time"
 )
 
@@ -59,21 +60,28 @@ type Balance struct {
 	MempoolNonce     uint32          `json:"mempoolNonce"`
 }
 
-func (api *DnaApi) GetBalance(address common.Address) Balance {
-	state := api.baseApi.getReadonlyAppState()
+func (api *DnaApi) GetBalance(address common.Address, blockNumber *uint64) (Balance, error) {
+	state, err := api.baseApi.getReadonlyAppStateAt(blockNumber)
+	if err != nil {
+		return Balance{}, err
+	}
 	currentEpoch := state.State.Epoch()
 	nonce, epoch := state.State.GetNonce(address), state.State.GetEpoch(address)
 	if epoch < currentEpoch {
 		nonce = 0
 	}
+	mempoolNonce := nonce
+	if blockNumber == nil {
+		mempoolNonce = state.NonceCache.GetNonce(address, currentEpoch)
+	}
 
 	return Balance{
 		Stake:            blockchain.ConvertToFloat(state.State.GetStakeBalance(address)),
 		ReplenishedStake: blockchain.ConvertToFloat(state.State.GetReplenishedStakeBalance(address)),
 		Balance:          blockchain.ConvertToFloat(state.State.GetBalance(address)),
 		Nonce:            nonce,
-		MempoolNonce:     state.NonceCache.GetNonce(address, currentEpoch),
-	}
+		MempoolNonce:     mempoolNonce,
+	}, nil
 }
 
 // SendTxArgs represents the arguments to submit a new transaction into the transaction pool.
@@ -344,40 +352,25 @@ func (api *DnaApi) Identities() []Identity {
 	return identities
 }
 
-func (api *DnaApi) Identity(address *common.Address) Identity {
+func (api *DnaApi) Identity(address *common.Address, blockNumber *uint64) (Identity, error) {
 	var flipKeyWordPairs []int
 	coinbase := api.GetCoinbaseAddr()
 	if address == nil || *address == coinbase {
 		address = &coinbase
-		flipKeyWordPairs = api.ceremony.FlipKeyWordPairs()
+		if blockNumber == nil {
+			flipKeyWordPairs = api.ceremony.FlipKeyWordPairs()
+		}
 	}
 
-	appState := api.baseApi.getReadonlyAppState()
-	return convertIdentity(appState.State.Epoch(), *address, appState.State.GetIdentity(*address), flipKeyWordPairs, appState)
+	appState, err := api.baseApi.getReadonlyAppStateAt(blockNumber)
+	if err != nil {
+		return Identity{}, err
+	}
+	return convertIdentity(appState.State.Epoch(), *address, appState.State.GetIdentity(*address), flipKeyWordPairs, appState), nil
 }
 
 func convertIdentity(currentEpoch uint16, address common.Address, data state.Identity, flipKeyWordPairs []int, appState *appstate.AppState) Identity {
-	var s string
-	switch data.State {
-	case state.Invite:
-		s = "Invite"
-	case state.Candidate:
-		s = "Candidate"
-	case state.Newbie:
-		s = "Newbie"
-	case state.Verified:
-		s = "Verified"
-	case state.Suspended:
-		s = "Suspended"
-	case state.Zombie:
-		s = "Zombie"
-	case state.Killed:
-		s = "Killed"
-	case state.Human:
-		s = "Human"
-	default:
-		s = "Undefined"
-	}
+	s := data.State.String()
 
 	var flags []string
 	if data.LastValidationStatus.HasFlag(state.AllFlipsNotQualified) {
@@ -498,23 +491,14 @@ type Epoch struct {
 	CurrentPeriod  string    `json:"currentPeriod"`
 }
 
-func (api *DnaApi) Epoch() Epoch {
-	s := api.baseApi.getReadonlyAppState()
-	var res string
-	switch s.State.ValidationPeriod() {
-	case state.NonePeriod:
-		res = "None"
-	case state.FlipLotteryPeriod:
-		res = "FlipLottery"
-		if api.ceremony.ShortSessionStarted() {
-			res = "ShortSession"
-		}
-	case state.ShortSessionPeriod:
+func (api *DnaApi) Epoch(blockNumber *uint64) (Epoch, error) {
+	s, err := api.baseApi.getReadonlyAppStateAt(blockNumber)
+	if err != nil {
+		return Epoch{}, err
+	}
+	res := validationPeriodName(s.State.ValidationPeriod())
+	if s.State.ValidationPeriod() == state.FlipLotteryPeriod && blockNumber == nil && api.ceremony.ShortSessionStarted() {
 		res = "ShortSession"
-	case state.LongSessionPeriod:
-		res = "LongSession"
-	case state.AfterLongSessionPeriod:
-		res = "AfterLongSession"
 	}
 
 	return Epoch{
@@ -522,6 +506,23 @@ func (api *DnaApi) Epoch() Epoch {
 		StartBlock:     s.State.EpochBlock(),
 		NextValidation: s.State.NextValidationTime(),
 		CurrentPeriod:  res,
+	}, nil
+}
+
+func validationPeriodName(period state.ValidationPeriod) string {
+	switch period {
+	case state.NonePeriod:
+		return "None"
+	case state.FlipLotteryPeriod:
+		return "FlipLottery"
+	case state.ShortSessionPeriod:
+		return "ShortSession"
+	case state.LongSessionPeriod:
+		return "LongSession"
+	case state.AfterLongSessionPeriod:
+		return "AfterLongSession"
+	default:
+		return ""
 	}
 }
 
@@ -542,6 +543,66 @@ func (api *DnaApi) CeremonyIntervals() CeremonyIntervals {
 	}
 }
 
+type CeremonyStatus struct {
+	Epoch                   uint16 `json:"epoch"`
+	CurrentPeriod           string `json:"currentPeriod"`
+	Phase                   string `json:"phase"`
+	PublicFlipKeys          int    `json:"publicFlipKeys"`
+	PrivateFlipKeysPackages int    `json:"privateFlipKeysPackages"`
+	AnswerHashes            int    `json:"answerHashes"`
+	ShortAnswers            int    `json:"shortAnswers"`
+	LongAnswers             int    `json:"longAnswers"`
+	EvidenceMaps            int    `json:"evidenceMaps"`
+	ValidationReady         bool   `json:"validationReady"`
+	Candidate               bool   `json:"candidate"`
+	ShortFlips              int    `json:"shortFlips"`
+	ShortFlipsLoaded        int    `json:"shortFlipsLoaded"`
+	ShortFlipsReady         int    `json:"shortFlipsReady"`
+	LongFlips               int    `json:"longFlips"`
+	LongFlipsLoaded         int    `json:"longFlipsLoaded"`
+	LongFlipsReady          int    `json:"longFlipsReady"`
+}
+
+// CeremonyStatus returns the live progress of the validation ceremony of the current epoch
+// and the readiness of the node coinbase
+func (api *DnaApi) CeremonyStatus() CeremonyStatus {
+	status := api.ceremony.Status()
+	return CeremonyStatus{
+		Epoch:                   status.Epoch,
+		CurrentPeriod:           validationPeriodName(status.Period),
+		Phase:                   ceremonyPhaseName(status.Phase),
+		PublicFlipKeys:          status.PublicFlipKeys,
+		PrivateFlipKeysPackages: status.PrivateFlipKeysPackages,
+		AnswerHashes:            status.AnswerHashes,
+		ShortAnswers:            status.ShortAnswers,
+		LongAnswers:             status.LongAnswers,
+		EvidenceMaps:            status.EvidenceMaps,
+		ValidationReady:         status.ValidationReady,
+		Candidate:               status.Candidate,
+		ShortFlips:              status.ShortFlips,
+		ShortFlipsLoaded:        status.ShortFlipsLoaded,
+		ShortFlipsReady:         status.ShortFlipsReady,
+		LongFlips:               status.LongFlips,
+		LongFlipsLoaded:         status.LongFlipsLoaded,
+		LongFlipsReady:          status.LongFlipsReady,
+	}
+}
+
+func ceremonyPhaseName(phase types.BlockFlag) string {
+	switch phase {
+	case types.FlipLotteryStarted:
+		return "FlipLotteryStarted"
+	case types.ShortSessionStarted:
+		return "ShortSessionStarted"
+	case types.LongSessionStarted:
+		return "LongSessionStarted"
+	case types.AfterLongSessionStarted:
+		return "AfterLongSessionStarted"
+	default:
+		return "None"
+	}
+}
+
 func (api *DnaApi) ExportKey(password string) (string, error) {
 	if password == "" {
 		return "", errors.New("password should not be empty")
@@ -821,3 +882,254 @@ func (api *DnaApi) SendToIpfs(ctx context.Context, args SendToIpfsArgs) (common.
 	}
 	return txHash, nil
 }
+
+type EstimateRewardsArgs struct {
+	// State is the identity state after the validation, candidates are assumed to become newbies and others to keep the state if empty
+	State      string        `json:"state"`
+	BadAuthor  bool          `json:"badAuthor"`
+	FlipGrades []types.Grade `json:"flipGrades"`
+	// Invitees are validated invitees, all current invitees are assumed to be validated if omitted
+	Invitees       []common.Address `json:"invitees"`
+	Reports        int              `json:"reports"`
+	NetworkReports int              `json:"networkReports"`
+	// NetworkFlipGrade is the grade assumed for flips of other identities, GradeC by default
+	NetworkFlipGrade *types.Grade `json:"networkFlipGrade"`
+}
+
+type EstimatedReward struct {
+	Balance decimal.Decimal `json:"balance"`
+	Stake   decimal.Decimal `json:"stake"`
+}
+
+type EstimatedRewards struct {
+	Epoch   uint16                      `json:"epoch"`
+	State   string                      `json:"state"`
+	Rewards map[string]*EstimatedReward `json:"rewards"`
+	Balance decimal.Decimal             `json:"balance"`
+	Stake   decimal.Decimal             `json:"stake"`
+}
+
+// EstimateRewards returns validation rewards of the current epoch the identity would get for the assumed validation outcome
+// given the current network size and stakes
+func (api *DnaApi) EstimateRewards(address common.Address, args EstimateRewardsArgs) (*EstimatedRewards, error) {
+	appState := api.baseApi.getAppStateForCheck()
+	identity := appState.State.GetIdentity(address)
+	assumptions := &blockchain.RewardAssumptions{
+		State:            identity.State,
+		BadAuthor:        args.BadAuthor,
+		FlipGrades:       args.FlipGrades,
+		Invitees:         args.Invitees,
+		Reports:          args.Reports,
+		NetworkReports:   args.NetworkReports,
+		NetworkFlipGrade: types.GradeC,
+	}
+	if identity.State == state.Candidate {
+		assumptions.State = state.Newbie
+	}
+	if len(args.State) > 0 {
+		newState, err := state.ParseIdentityState(args.State)
+		if err != nil {
+			return nil, err
+		}
+		assumptions.State = newState
+	}
+	if args.NetworkFlipGrade != nil {
+		assumptions.NetworkFlipGrade = *args.NetworkFlipGrade
+	}
+	for _, grade := range args.FlipGrades {
+		if grade > types.GradeA {
+			return nil, errors.Errorf("invalid flip grade %v", grade)
+		}
+	}
+	if args.Reports < 0 || args.NetworkReports < 0 {
+		return nil, errors.New("reports should not be negative")
+	}
+	epoch := appState.State.Epoch()
+	epochDurations := blockchain.EpochDurations(appState, api.bc.Head.Height())
+	rewards, err := blockchain.EstimateRewards(appState, api.bc.Config().Consensus, address, assumptions, epochDurations)
+	if err != nil {
+		return nil, err
+	}
+	res := &EstimatedRewards{
+		Epoch:   epoch,
+		State:   assumptions.State.String(),
+		Rewards: make(map[string]*EstimatedReward, len(rewards)),
+	}
+	balance, stake := new(big.Int), new(big.Int)
+	for rewardType, reward := range rewards {
+		res.Rewards[rewardType] = &EstimatedReward{
+			Balance: blockchain.ConvertToFloat(reward.Balance),
+			Stake:   blockchain.ConvertToFloat(reward.Stake),
+		}
+		balance.Add(balance, reward.Balance)
+		stake.Add(stake, reward.Stake)
+	}
+	res.Balance = blockchain.ConvertToFloat(balance)
+	res.Stake = blockchain.ConvertToFloat(stake)
+	return res, nil
+}
+
+type FlipValidationResult struct {
+	Cid              string      `json:"cid"`
+	Status           string      `json:"status"`
+	Answer           string      `json:"answer"`
+	Grade            types.Grade `json:"grade"`
+	NotApproved      bool        `json:"notApproved"`
+	RespondentAnswer string      `json:"respondentAnswer"`
+	RespondentGrade  types.Grade `json:"respondentGrade"`
+	Point            float32     `json:"point"`
+	Considered       bool        `json:"considered"`
+}
+
+type ValidationResult struct {
+	Address                 common.Address          `json:"address"`
+	Epoch                   uint16                  `json:"epoch"`
+	ShardId                 uint32                  `json:"shardId"`
+	PrevState               string                  `json:"prevState"`
+	NewState                string                  `json:"newState"`
+	Rule                    string                  `json:"rule"`
+	RuleDescription         string                  `json:"ruleDescription"`
+	HasDoneAllRequiredFlips bool                    `json:"hasDoneAllRequiredFlips"`
+	Candidate               bool                    `json:"candidate"`
+	Approved                bool                    `json:"approved"`
+	Missed                  bool                    `json:"missed"`
+	NoAnswersShort          bool                    `json:"noAnswersShort"`
+	NoAnswersLong           bool                    `json:"noAnswersLong"`
+	NoQualifiedShort        bool                    `json:"noQualifiedShort"`
+	NoQualifiedLong         bool                    `json:"noQualifiedLong"`
+	ShortPoint              float32                 `json:"shortPoint"`
+	ShortQualifiedFlips     uint32                  `json:"shortQualifiedFlips"`
+	ShortScore              float32                 `json:"shortScore"`
+	LongPoint               float32                 `json:"longPoint"`
+	LongQualifiedFlips      uint32                  `json:"longQualifiedFlips"`
+	LongScore               float32                 `json:"longScore"`
+	PrevTotalPoints         float32                 `json:"prevTotalPoints"`
+	PrevTotalQualifiedFlips uint32                  `json:"prevTotalQualifiedFlips"`
+	TotalScore              float32                 `json:"totalScore"`
+	TotalQualifiedFlips     uint32                  `json:"totalQualifiedFlips"`
+	ShortFlips              []*FlipValidationResult `json:"shortFlips"`
+	LongFlips               []*FlipValidationResult `json:"longFlips"`
+	BadAuthor               bool                    `json:"badAuthor"`
+	BadAuthorReason         string                  `json:"badAuthorReason,omitempty"`
+	HasOneReportedFlip      bool                    `json:"hasOneReportedFlip"`
+	HasOneNotQualifiedFlip  bool                    `json:"hasOneNotQualifiedFlip"`
+	AllFlipsNotQualified    bool                    `json:"allFlipsNotQualified"`
+}
+
+func flipStatusName(status ceremony.FlipStatus) string {
+	switch status {
+	case ceremony.Qualified:
+		return "Qualified"
+	case ceremony.WeaklyQualified:
+		return "WeaklyQualified"
+	case ceremony.QualifiedByNone:
+		return "QualifiedByNone"
+	default:
+		return "NotQualified"
+	}
+}
+
+func answerName(answer types.Answer) string {
+	switch answer {
+	case types.Left:
+		return "Left"
+	case types.Right:
+		return "Right"
+	default:
+		return "None"
+	}
+}
+
+func badAuthorReasonName(reason types.BadAuthorReason) string {
+	switch reason {
+	case types.NoQualifiedFlipsBadAuthor:
+		return "NoQualifiedFlips"
+	case types.QualifiedByNoneBadAuthor:
+		return "QualifiedByNone"
+	case types.WrongWordsBadAuthor:
+		return "WrongWords"
+	default:
+		return "Unknown"
+	}
+}
+
+func convertFlipValidationResults(flips []*ceremony.FlipValidationResult) []*FlipValidationResult {
+	res := make([]*FlipValidationResult, 0, len(flips))
+	for _, flip := range flips {
+		var flipCid string
+		if c, err := cid.Parse(flip.Cid); err == nil {
+			flipCid = c.String()
+		}
+		res = append(res, &FlipValidationResult{
+			Cid:              flipCid,
+			Status:           flipStatusName(flip.Status),
+			Answer:           answerName(flip.Answer),
+			Grade:            flip.Grade,
+			NotApproved:      flip.NotApproved,
+			RespondentAnswer: answerName(flip.RespondentAnswer),
+			RespondentGrade:  flip.RespondentGrade,
+			Point:            flip.Point,
+			Considered:       flip.Considered,
+		})
+	}
+	return res
+}
+
+// ValidationResult returns the validation breakdown of the identity and the rule which decided its new state,
+// the last finished validation is used if the epoch is not specified
+func (api *DnaApi) ValidationResult(address common.Address, epoch *uint16) (*ValidationResult, error) {
+	if epoch == nil {
+		currentEpoch := api.baseApi.getReadonlyAppState().State.Epoch()
+		if currentEpoch == 0 {
+			return nil, errors.New("no finished validations")
+		}
+		prevEpoch := currentEpoch - 1
+		epoch = &prevEpoch
+	}
+	result, err := api.ceremony.ValidationResult(address, *epoch)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errors.Errorf("validation result of epoch %v is not found", *epoch)
+	}
+	res := &ValidationResult{
+		Address:                 address,
+		Epoch:                   result.Epoch,
+		ShardId:                 uint32(result.ShardId),
+		PrevState:               result.PrevState.String(),
+		NewState:                result.NewState.String(),
+		Rule:                    string(result.Rule),
+		RuleDescription:         ceremony.ValidationRuleDescriptions[result.Rule],
+		HasDoneAllRequiredFlips: result.HasDoneAllRequiredFlips,
+		Candidate:               result.Candidate,
+		Approved:                result.Approved,
+		Missed:                  result.Missed,
+		NoAnswersShort:          result.NoAnswersShort,
+		NoAnswersLong:           result.NoAnswersLong,
+		NoQualifiedShort:        result.NoQualifiedShort,
+		NoQualifiedLong:         result.NoQualifiedLong,
+		ShortPoint:              result.ShortPoint,
+		ShortQualifiedFlips:     result.ShortQualifiedFlips,
+		ShortScore:              result.ShortScore,
+		LongPoint:               result.LongPoint,
+		LongQualifiedFlips:      result.LongQualifiedFlips,
+		LongScore:               result.LongScore,
+		PrevTotalPoints:         result.PrevTotalPoints,
+		PrevTotalQualifiedFlips: result.PrevTotalQualifiedFlips,
+		TotalScore:              result.TotalScore,
+		TotalQualifiedFlips:     result.TotalQualifiedFlips,
+		ShortFlips:              convertFlipValidationResults(result.ShortFlips),
+		LongFlips:               convertFlipValidationResults(result.LongFlips),
+	}
+	if result.BadAuthor != nil {
+		res.BadAuthor = true
+		res.BadAuthorReason = badAuthorReasonName(*result.BadAuthor)
+	}
+	if result.AuthorResults != nil {
+		res.HasOneReportedFlip = result.AuthorResults.HasOneReportedFlip
+		res.HasOneNotQualifiedFlip = result.AuthorResults.HasOneNotQualifiedFlip
+		res.AllFlipsNotQualified = result.AuthorResults.AllFlipsNotQualified
+	}
+	return res, nil
+}
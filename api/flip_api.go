@@ -11,6 +11,7 @@ import (
 	"github.com/idena-network/idena-go/core/ceremony"
 	"github.com/idena-network/idena-go/core/flip"
 	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/log"
 	"github.com/ipfs/go-cid"
@@ -515,3 +516,159 @@ func (api *FlipApi) WordPairs(addr common.Address, vrfHash hexutil.Bytes) []Flip
 
 	return convertedFlipKeyWordPairs
 }
+
+type LotteryIdentity struct {
+	Address                 common.Address `json:"address"`
+	PubKey                  hexutil.Bytes  `json:"pubKey"`
+	State                   string         `json:"state"`
+	HasDoneAllRequiredFlips bool           `json:"hasDoneAllRequiredFlips"`
+	Flips                   []string       `json:"flips"`
+	// Proof proves the identity against the state root of the lottery block header
+	Proof hexutil.Bytes `json:"proof,omitempty"`
+}
+
+type LotteryProof struct {
+	Epoch           uint16             `json:"epoch"`
+	Address         common.Address     `json:"address"`
+	ShardId         uint32             `json:"shardId"`
+	Seed            hexutil.Bytes      `json:"seed"`
+	ShortFlipsCount int                `json:"shortFlipsCount"`
+	Identities      []*LotteryIdentity `json:"identities"`
+	ShortFlips      []string           `json:"shortFlips"`
+	LongFlips       []string           `json:"longFlips"`
+	Height          uint64             `json:"height"`
+	SeedHeight      uint64             `json:"seedHeight"`
+	Header          hexutil.Bytes      `json:"header,omitempty"`
+	SeedHeader      hexutil.Bytes      `json:"seedHeader,omitempty"`
+}
+
+// LotteryProof returns inputs of the flip lottery of the identity shard along with flips assigned to the identity,
+// the current epoch is used if epoch is not set
+func (api *FlipApi) LotteryProof(address common.Address, epoch *uint16) (*LotteryProof, error) {
+	if epoch == nil {
+		currentEpoch := api.baseApi.getReadonlyAppState().State.Epoch()
+		epoch = &currentEpoch
+	}
+	proof, err := api.ceremony.LotteryProof(address, *epoch)
+	if err != nil {
+		return nil, err
+	}
+	res := &LotteryProof{
+		Epoch:           proof.Epoch,
+		Address:         proof.Address,
+		ShardId:         uint32(proof.ShardId),
+		Seed:            proof.Seed,
+		ShortFlipsCount: proof.ShortFlipsCount,
+		Identities:      make([]*LotteryIdentity, 0, len(proof.Identities)),
+		Height:          proof.Height,
+		SeedHeight:      proof.SeedHeight,
+	}
+	if proof.Header != nil && proof.SeedHeader != nil {
+		if res.Header, err = proof.Header.ToBytes(); err != nil {
+			return nil, err
+		}
+		if res.SeedHeader, err = proof.SeedHeader.ToBytes(); err != nil {
+			return nil, err
+		}
+	}
+	for i, identity := range proof.Identities {
+		flips, err := flipCidsToStrings(identity.FlipCids)
+		if err != nil {
+			return nil, err
+		}
+		item := &LotteryIdentity{
+			Address:                 identity.Address,
+			PubKey:                  identity.PubKey,
+			State:                   state.IdentityState(identity.State).String(),
+			HasDoneAllRequiredFlips: identity.HasDoneAllRequiredFlips,
+			Flips:                   flips,
+		}
+		if len(proof.IdentityProofs) == len(proof.Identities) {
+			item.Proof = proof.IdentityProofs[i]
+		}
+		res.Identities = append(res.Identities, item)
+	}
+	if res.ShortFlips, err = flipCidsToStrings(proof.ShortFlips); err != nil {
+		return nil, err
+	}
+	if res.LongFlips, err = flipCidsToStrings(proof.LongFlips); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CeremonyProof converts the proof back to the form checked by ceremony.VerifyLotteryProof
+func (proof *LotteryProof) CeremonyProof() (*ceremony.LotteryProof, error) {
+	res := &ceremony.LotteryProof{
+		Epoch:           proof.Epoch,
+		Address:         proof.Address,
+		ShardId:         common.ShardId(proof.ShardId),
+		Seed:            proof.Seed,
+		ShortFlipsCount: proof.ShortFlipsCount,
+		Identities:      make([]database.DbLotteryIdentity, 0, len(proof.Identities)),
+		Height:          proof.Height,
+		SeedHeight:      proof.SeedHeight,
+	}
+	if len(proof.Header) > 0 && len(proof.SeedHeader) > 0 {
+		res.Header, res.SeedHeader = new(types.Header), new(types.Header)
+		if err := res.Header.FromBytes(proof.Header); err != nil {
+			return nil, errors.Wrap(err, "invalid header")
+		}
+		if err := res.SeedHeader.FromBytes(proof.SeedHeader); err != nil {
+			return nil, errors.Wrap(err, "invalid seed header")
+		}
+	}
+	for _, identity := range proof.Identities {
+		identityState, err := state.ParseIdentityState(identity.State)
+		if err != nil {
+			return nil, err
+		}
+		flipCids, err := flipCidsFromStrings(identity.Flips)
+		if err != nil {
+			return nil, err
+		}
+		res.Identities = append(res.Identities, database.DbLotteryIdentity{
+			Address:                 identity.Address,
+			ShiftedShardId:          res.ShardId,
+			FlipCids:                flipCids,
+			PubKey:                  identity.PubKey,
+			State:                   uint8(identityState),
+			HasDoneAllRequiredFlips: identity.HasDoneAllRequiredFlips,
+		})
+		if len(identity.Proof) > 0 {
+			res.IdentityProofs = append(res.IdentityProofs, identity.Proof)
+		}
+	}
+	var err error
+	if res.ShortFlips, err = flipCidsFromStrings(proof.ShortFlips); err != nil {
+		return nil, err
+	}
+	if res.LongFlips, err = flipCidsFromStrings(proof.LongFlips); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func flipCidsToStrings(cids [][]byte) ([]string, error) {
+	res := make([]string, 0, len(cids))
+	for _, item := range cids {
+		c, err := cid.Parse(item)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid flip cid %x", item)
+		}
+		res = append(res, c.String())
+	}
+	return res, nil
+}
+
+func flipCidsFromStrings(cids []string) ([][]byte, error) {
+	var res [][]byte
+	for _, item := range cids {
+		c, err := cid.Decode(item)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid flip cid %v", item)
+		}
+		res = append(res, c.Bytes())
+	}
+	return res, nil
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/consensus"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/protocol"
+	"github.com/pkg/errors"
+)
+
+// LightApi is served by light nodes instead of BlockchainApi, light nodes keep block headers only
+// so blocks have no transactions and the state is requested from full peers with proofs
+type LightApi struct {
+	bc     *blockchain.Blockchain
+	d      *protocol.Downloader
+	pm     *protocol.IdenaGossipHandler
+	engine *consensus.Engine
+}
+
+func NewLightApi(bc *blockchain.Blockchain, d *protocol.Downloader, pm *protocol.IdenaGossipHandler, engine *consensus.Engine) *LightApi {
+	return &LightApi{bc, d, pm, engine}
+}
+
+// head returns the last followed header, the head of the chain stays at genesis since blocks aren't applied
+func (api *LightApi) head() *types.Header {
+	if head := api.bc.PreliminaryHead; head != nil {
+		return head
+	}
+	return api.bc.Head
+}
+
+func (api *LightApi) LastBlock() *Block {
+	return api.BlockAt(api.head().Height())
+}
+
+func (api *LightApi) BlockAt(height uint64) *Block {
+	header := api.bc.GetBlockHeaderByHeight(height)
+	if header == nil {
+		return nil
+	}
+	return convertToBlock(&types.Block{Header: header})
+}
+
+func (api *LightApi) Syncing() Syncing {
+	isSyncing := api.d.IsSyncing() || !api.pm.HasPeers() || !api.engine.Synced()
+	current, highest := api.d.SyncProgress()
+	if !isSyncing {
+		highest = current
+	}
+	return Syncing{
+		Syncing:      isSyncing,
+		GenesisBlock: api.bc.GenesisInfo().Genesis.Height(),
+		CurrentBlock: current,
+		HighestBlock: highest,
+		WrongTime:    api.pm.WrongTime(),
+	}
+}
+
+// GetAccountProof requests the account state with the proof from full peers
+func (api *LightApi) GetAccountProof(address common.Address, height uint64) (*StateProof, error) {
+	header, err := api.header(height)
+	if err != nil {
+		return nil, err
+	}
+	return api.stateProof(header, protocol.AccountStateProof, address, header.Root())
+}
+
+// GetIdentityProof requests the identity state and the approved identity state with the proofs from full peers.
+// Absence of the identity in the identity tree can't be proven, so an error is returned
+// if no peer provides the approved identity proof
+func (api *LightApi) GetIdentityProof(address common.Address, height uint64) (*IdentityProof, error) {
+	header, err := api.header(height)
+	if err != nil {
+		return nil, err
+	}
+	res := &IdentityProof{}
+	if res.Identity, err = api.stateProof(header, protocol.IdentityStateProof, address, header.Root()); err != nil {
+		return nil, err
+	}
+	if res.ApprovedIdentity, err = api.stateProof(header, protocol.ApprovedIdentityStateProof, address, header.IdentityRoot()); err != nil {
+		return nil, errors.Wrap(err, "approved identity proof is unavailable in light mode")
+	}
+	return res, nil
+}
+
+func (api *LightApi) header(height uint64) (*types.Header, error) {
+	header := api.bc.GetBlockHeaderByHeight(height)
+	if header == nil {
+		return nil, errors.Errorf("block %v is not found", height)
+	}
+	return header, nil
+}
+
+func (api *LightApi) stateProof(header *types.Header, proofType protocol.StateProofType, address common.Address, root common.Hash) (*StateProof, error) {
+	proof, err := api.d.LightClient().GetStateProof(header.Height(), proofType, address)
+	if err != nil {
+		return nil, err
+	}
+	return newStateProof(header.Height(), root, proofKey(proofType, address), proof)
+}
+
+func proofKey(proofType protocol.StateProofType, address common.Address) []byte {
+	if proofType == protocol.AccountStateProof {
+		return state.StateDbKeys.AddressKey(address)
+	}
+	return state.StateDbKeys.IdentityKey(address)
+}
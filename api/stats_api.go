@@ -0,0 +1,214 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const maxStatsBlockRange = 1000
+
+// StatsApi provides block and epoch stats saved by the built-in stats collector
+type StatsApi struct {
+	store collector.StatsStore
+}
+
+// NewStatsApi creates a new StatsApi instance
+func NewStatsApi(store collector.StatsStore) *StatsApi {
+	return &StatsApi{store: store}
+}
+
+type BalanceUpdate struct {
+	Address    common.Address  `json:"address"`
+	Height     uint64          `json:"height"`
+	Reason     string          `json:"reason"`
+	TxHash     *common.Hash    `json:"txHash,omitempty"`
+	BalanceOld decimal.Decimal `json:"balanceOld"`
+	BalanceNew decimal.Decimal `json:"balanceNew"`
+	StakeOld   decimal.Decimal `json:"stakeOld"`
+	StakeNew   decimal.Decimal `json:"stakeNew"`
+	PenaltyOld decimal.Decimal `json:"penaltyOld"`
+	PenaltyNew decimal.Decimal `json:"penaltyNew"`
+}
+
+type Burn struct {
+	Address common.Address  `json:"address"`
+	Reason  string          `json:"reason"`
+	Amount  decimal.Decimal `json:"amount"`
+	TxHash  *common.Hash    `json:"txHash,omitempty"`
+}
+
+type Reward struct {
+	Type         string          `json:"type"`
+	Address      common.Address  `json:"address"`
+	StakeAddress common.Address  `json:"stakeAddress"`
+	Balance      decimal.Decimal `json:"balance"`
+	Stake        decimal.Decimal `json:"stake"`
+}
+
+type BlockStats struct {
+	Height         uint64           `json:"height"`
+	Hash           common.Hash      `json:"hash"`
+	Minted         decimal.Decimal  `json:"minted"`
+	Burnt          decimal.Decimal  `json:"burnt"`
+	Fees           decimal.Decimal  `json:"fees"`
+	Burns          []*Burn          `json:"burns"`
+	BalanceUpdates []*BalanceUpdate `json:"balanceUpdates"`
+	Rewards        []*Reward        `json:"rewards"`
+}
+
+type RewardTotal struct {
+	Amount decimal.Decimal  `json:"amount"`
+	Share  *decimal.Decimal `json:"share,omitempty"`
+}
+
+type EpochRewards struct {
+	Epoch   uint16                  `json:"epoch"`
+	Height  uint64                  `json:"height"`
+	Total   decimal.Decimal         `json:"total"`
+	Totals  map[string]*RewardTotal `json:"totals"`
+	Rewards []*Reward               `json:"rewards"`
+}
+
+func convertReward(reward *collector.Reward) *Reward {
+	return &Reward{
+		Type:         reward.Type,
+		Address:      reward.Address,
+		StakeAddress: reward.StakeAddress,
+		Balance:      blockchain.ConvertToFloat(reward.Balance),
+		Stake:        blockchain.ConvertToFloat(reward.Stake),
+	}
+}
+
+func convertBalanceUpdate(height uint64, update *collector.BalanceUpdate) *BalanceUpdate {
+	return &BalanceUpdate{
+		Address:    update.Address,
+		Height:     height,
+		Reason:     update.Reason,
+		TxHash:     update.TxHash,
+		BalanceOld: blockchain.ConvertToFloat(update.BalanceOld),
+		BalanceNew: blockchain.ConvertToFloat(update.BalanceNew),
+		StakeOld:   blockchain.ConvertToFloat(update.StakeOld),
+		StakeNew:   blockchain.ConvertToFloat(update.StakeNew),
+		PenaltyOld: blockchain.ConvertToFloat(update.PenaltyOld),
+		PenaltyNew: blockchain.ConvertToFloat(update.PenaltyNew),
+	}
+}
+
+func convertBlockStats(stats *collector.BlockStats) *BlockStats {
+	res := &BlockStats{
+		Height:         stats.Height,
+		Hash:           stats.Hash,
+		Minted:         blockchain.ConvertToFloat(stats.Minted),
+		Burnt:          blockchain.ConvertToFloat(stats.Burnt),
+		Fees:           blockchain.ConvertToFloat(stats.Fees),
+		Burns:          make([]*Burn, 0, len(stats.Burns)),
+		BalanceUpdates: make([]*BalanceUpdate, 0, len(stats.BalanceUpdates)),
+		Rewards:        make([]*Reward, 0, len(stats.Rewards)),
+	}
+	for _, burn := range stats.Burns {
+		res.Burns = append(res.Burns, &Burn{
+			Address: burn.Address,
+			Reason:  burn.Reason,
+			Amount:  blockchain.ConvertToFloat(burn.Amount),
+			TxHash:  burn.TxHash,
+		})
+	}
+	for _, update := range stats.BalanceUpdates {
+		res.BalanceUpdates = append(res.BalanceUpdates, convertBalanceUpdate(stats.Height, update))
+	}
+	for _, reward := range stats.Rewards {
+		res.Rewards = append(res.Rewards, convertReward(reward))
+	}
+	return res
+}
+
+func convertEpochStats(stats *collector.EpochStats, filter func(reward *collector.Reward) bool) *EpochRewards {
+	res := &EpochRewards{
+		Epoch:   stats.Epoch,
+		Height:  stats.Height,
+		Total:   blockchain.ConvertToFloat(stats.Total),
+		Totals:  make(map[string]*RewardTotal, len(stats.Totals)),
+		Rewards: make([]*Reward, 0),
+	}
+	for rewardType, total := range stats.Totals {
+		converted := &RewardTotal{Amount: blockchain.ConvertToFloat(total.Amount)}
+		if total.Share != nil {
+			share := blockchain.ConvertToFloat(total.Share)
+			converted.Share = &share
+		}
+		res.Totals[rewardType] = converted
+	}
+	for _, reward := range stats.Rewards {
+		if filter == nil || filter(reward) {
+			res.Rewards = append(res.Rewards, convertReward(reward))
+		}
+	}
+	return res
+}
+
+// Block returns minted and burnt coins, rewards and balance updates of the block
+func (api *StatsApi) Block(height uint64) (*BlockStats, error) {
+	stats, err := api.store.BlockStats(height)
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, errors.Errorf("stats of block %v not found", height)
+	}
+	return convertBlockStats(stats), nil
+}
+
+// EpochRewards returns the reward distribution of the validation finishing the epoch
+func (api *StatsApi) EpochRewards(epoch uint16) (*EpochRewards, error) {
+	stats, err := api.store.EpochStats(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, errors.Errorf("stats of epoch %v not found", epoch)
+	}
+	return convertEpochStats(stats, nil), nil
+}
+
+// AddressRewards returns validation rewards of the epoch paid to the address balance or stake
+func (api *StatsApi) AddressRewards(epoch uint16, address common.Address) (*EpochRewards, error) {
+	stats, err := api.store.EpochStats(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, errors.Errorf("stats of epoch %v not found", epoch)
+	}
+	return convertEpochStats(stats, func(reward *collector.Reward) bool {
+		return reward.Address == address || reward.StakeAddress == address
+	}), nil
+}
+
+// BalanceUpdates returns balance updates of the address in the block range, blocks without stats are skipped
+func (api *StatsApi) BalanceUpdates(address common.Address, from, to uint64) ([]*BalanceUpdate, error) {
+	if from > to {
+		return nil, errors.New("invalid block range")
+	}
+	if to-from >= maxStatsBlockRange {
+		return nil, errors.Errorf("block range should not exceed %v", maxStatsBlockRange)
+	}
+	res := make([]*BalanceUpdate, 0)
+	for height := from; height <= to; height++ {
+		stats, err := api.store.BlockStats(height)
+		if err != nil {
+			return nil, err
+		}
+		if stats == nil {
+			continue
+		}
+		for _, update := range stats.BalanceUpdates {
+			if update.Address == address {
+				res = append(res, convertBalanceUpdate(height, update))
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/rpc"
+	"reflect"
+)
+
+const subscriptionBufferSize = 1000
+
+// BlockchainSubscriptionApi provides bcn push subscriptions for connections supporting notifications
+type BlockchainSubscriptionApi struct {
+	bus eventbus.Bus
+}
+
+// NewBlockchainSubscriptionApi creates a new BlockchainSubscriptionApi instance
+func NewBlockchainSubscriptionApi(bus eventbus.Bus) *BlockchainSubscriptionApi {
+	return &BlockchainSubscriptionApi{bus}
+}
+
+// NewHeads sends a notification each time a new block is added to the chain
+func (api *BlockchainSubscriptionApi) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return subscribe(ctx, api.bus, events.AddBlockEventID, func(e eventbus.Event) []interface{} {
+		return []interface{}{convertToBlock(e.(*events.NewBlockEvent).Block)}
+	})
+}
+
+// PendingTxs sends a notification each time a new transaction enters the mempool
+func (api *BlockchainSubscriptionApi) PendingTxs(ctx context.Context) (*rpc.Subscription, error) {
+	return subscribe(ctx, api.bus, events.NewTxEventID, func(e eventbus.Event) []interface{} {
+		txEvent := e.(*events.NewTxEvent)
+		if txEvent.Deferred {
+			return nil
+		}
+		return []interface{}{convertToTransaction(txEvent.Tx, common.Hash{}, nil, 0)}
+	})
+}
+
+// DnaSubscriptionApi provides dna push subscriptions for connections supporting notifications
+type DnaSubscriptionApi struct {
+	bus     eventbus.Bus
+	baseApi *BaseApi
+}
+
+// NewDnaSubscriptionApi creates a new DnaSubscriptionApi instance
+func NewDnaSubscriptionApi(bus eventbus.Bus, baseApi *BaseApi) *DnaSubscriptionApi {
+	return &DnaSubscriptionApi{bus, baseApi}
+}
+
+// IdentityChanges sends a notification with the identity data each time a new block changes the identity of the address
+func (api *DnaSubscriptionApi) IdentityChanges(ctx context.Context, address common.Address) (*rpc.Subscription, error) {
+	last, err := api.identity(address)
+	if err != nil {
+		return nil, err
+	}
+	return subscribe(ctx, api.bus, events.AddBlockEventID, func(e eventbus.Event) []interface{} {
+		identity, err := api.identity(address)
+		if err != nil || reflect.DeepEqual(identity, last) {
+			return nil
+		}
+		last = identity
+		return []interface{}{identity}
+	})
+}
+
+func (api *DnaSubscriptionApi) identity(address common.Address) (Identity, error) {
+	appState, err := api.baseApi.engine.ReadonlyAppState()
+	if err != nil {
+		return Identity{}, err
+	}
+	return convertIdentity(appState.State.Epoch(), address, appState.State.GetIdentity(address), nil, appState), nil
+}
+
+// ContractSubscriptionApi provides contract push subscriptions for connections supporting notifications
+type ContractSubscriptionApi struct {
+	bus eventbus.Bus
+}
+
+// NewContractSubscriptionApi creates a new ContractSubscriptionApi instance
+func NewContractSubscriptionApi(bus eventbus.Bus) *ContractSubscriptionApi {
+	return &ContractSubscriptionApi{bus}
+}
+
+type EventsSubscriptionArgs struct {
+	Contract common.Address `json:"contract"`
+	// Event is an optional event name, all contract events are sent if it is empty
+	Event string `json:"event"`
+}
+
+type EventNotification struct {
+	Contract    common.Address  `json:"contract"`
+	Event       string          `json:"event"`
+	Args        []hexutil.Bytes `json:"args"`
+	TxHash      common.Hash     `json:"txHash"`
+	BlockHash   common.Hash     `json:"blockHash"`
+	BlockHeight uint64          `json:"blockHeight"`
+}
+
+// Events sends a notification for each contract event emitted by transactions of a new block
+func (api *ContractSubscriptionApi) Events(ctx context.Context, args EventsSubscriptionArgs) (*rpc.Subscription, error) {
+	return subscribe(ctx, api.bus, events.AddBlockEventID, func(e eventbus.Event) []interface{} {
+		blockEvent := e.(*events.NewBlockEvent)
+		var result []interface{}
+		for _, receipt := range blockEvent.Receipts {
+			for _, txEvent := range receipt.Events {
+				if txEvent.Contract != args.Contract || len(args.Event) > 0 && txEvent.EventName != args.Event {
+					continue
+				}
+				notification := &EventNotification{
+					Contract:    txEvent.Contract,
+					Event:       txEvent.EventName,
+					TxHash:      receipt.TxHash,
+					BlockHash:   blockEvent.Block.Hash(),
+					BlockHeight: blockEvent.Block.Height(),
+				}
+				for _, arg := range txEvent.Data {
+					notification.Args = append(notification.Args, arg)
+				}
+				result = append(result, notification)
+			}
+		}
+		return result
+	})
+}
+
+// subscribe creates a subscription which converts events of the given type to notifications.
+// Events are converted and sent in a separate goroutine so slow clients don't block event publishers.
+func subscribe(ctx context.Context, bus eventbus.Bus, eventID eventbus.EventID, convert func(e eventbus.Event) []interface{}) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+	queue := make(chan eventbus.Event, subscriptionBufferSize)
+	busSub := bus.Subscribe(eventID, func(e eventbus.Event) {
+		select {
+		case queue <- e:
+		default:
+			log.Warn("Subscription queue is full, event skipped", "id", rpcSub.ID, "event", eventID)
+		}
+	})
+	go func() {
+		defer bus.Unsubscribe(busSub)
+		for {
+			select {
+			case e := <-queue:
+				for _, data := range convert(e) {
+					notifier.Notify(rpcSub.ID, data)
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
@@ -450,7 +450,8 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 			chain.genesisInfo.Genesis = block.Header
 		}
 		chain.bus.Publish(&events.NewBlockEvent{
-			Block: block,
+			Block:    block,
+			Receipts: blockInsertionResult.txReceipts,
 		})
 		if block.Header.Flags().HasFlag(types.ValidationFinished) {
 			shardId, _ := chain.CoinbaseShard()
@@ -579,14 +580,7 @@ func (chain *Blockchain) applyNewEpoch(appState *appstate.AppState, block *types
 	totalNewbies, totalVerified, totalSuspended, newbiesByShard, verifiedByShard, suspendedByShard := setNewIdentitiesAttributes(appState, chain.config.Consensus.EnableUpgrade8, totalInvitesCount, networkSize, pools, failed, validationResults, statsCollector)
 	epochBlock := appState.State.EpochBlock()
 	if !failed {
-		var epochDurations []uint32
-		prevEpochBlocks := appState.State.PrevEpochBlocks()
-		epochBlocks := append(prevEpochBlocks, []uint64{epochBlock, block.Height()}...)
-		epochDurationsLen := len(epochBlocks) - 1
-		epochDurations = make([]uint32, 0, epochDurationsLen)
-		for i := 0; i < epochDurationsLen; i++ {
-			epochDurations = append(epochDurations, uint32(epochBlocks[i+1]-epochBlocks[i]))
-		}
+		epochDurations := EpochDurations(appState, block.Height())
 		rewardValidIdentities(appState, chain.config.Consensus, validationResults, epochDurations, statsCollector)
 		balanceShards(appState, totalNewbies, totalVerified, totalSuspended, newbiesByShard, verifiedByShard, suspendedByShard)
 	}
@@ -1459,10 +1453,14 @@ func (chain *Blockchain) applyNextBlockFee(appState *appstate.AppState, block *t
 }
 
 func (chain *Blockchain) calculateNextBlockFeePerGas(appState *appstate.AppState, block *types.Block, usedGas uint64) *big.Int {
+	return chain.CalculateNextBlockFeePerGas(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerGas(), usedGas)
+}
 
-	minFeePerGas := fee.GetFeePerGasForNetwork(appState.ValidatorsCache.NetworkSize())
+// CalculateNextBlockFeePerGas returns fee per gas of the block following a block with the given fee per gas and used gas
+func (chain *Blockchain) CalculateNextBlockFeePerGas(networkSize int, feePerGas *big.Int, usedGas uint64) *big.Int {
+
+	minFeePerGas := fee.GetFeePerGasForNetwork(networkSize)
 
-	feePerGas := appState.State.FeePerGas()
 	if common.ZeroOrNil(feePerGas) || feePerGas.Cmp(minFeePerGas) == -1 {
 		feePerGas = new(big.Int).Set(minFeePerGas)
 	}
@@ -1722,7 +1720,11 @@ func calculateTxBloom(block *types.Block, receipts types.TxReceipts) []byte {
 
 	for _, r := range receipts {
 		for _, e := range r.Events {
-			values[string(append(r.ContractAddress.Bytes(), []byte(e.EventName)...))] = struct{}{}
+			values[string(append(e.Contract.Bytes(), []byte(e.EventName)...))] = struct{}{}
+			// a called contract isn't a tx recipient, so its address is added to find its events by the address only
+			if e.Contract != r.ContractAddress {
+				values[string(e.Contract.Bytes())] = struct{}{}
+			}
 		}
 	}
 
@@ -1879,10 +1881,10 @@ func (chain *Blockchain) WriteTxReceipts(cid []byte, receipts types.TxReceipts)
 			ReceiptCid: cid,
 		}
 		chain.repo.WriteReceiptIndex(r.TxHash, idx)
-		if eventMap, ok := m[r.ContractAddress]; ok {
-			for idx, event := range r.Events {
+		for idx, event := range r.Events {
+			if eventMap, ok := m[event.Contract]; ok {
 				if _, ok := eventMap[event.EventName]; ok {
-					chain.repo.WriteEvent(r.ContractAddress, r.TxHash, uint32(idx), event)
+					chain.repo.WriteEvent(event.Contract, r.TxHash, uint32(idx), event)
 				}
 			}
 		}
@@ -2193,6 +2195,67 @@ func (chain *Blockchain) GetBlockHeaderByHeight(height uint64) *types.Header {
 	return chain.repo.ReadBlockHeader(hash)
 }
 
+// GetAccountWithProof returns the account state of the given block with the proof verifiable against the block state root
+func (chain *Blockchain) GetAccountWithProof(height uint64, addr common.Address) ([]byte, error) {
+	stateDb, err := chain.stateForProof(height)
+	if err != nil {
+		return nil, err
+	}
+	return stateDb.GetAccountWithProof(addr)
+}
+
+// GetIdentityWithProof returns the identity state of the given block with the proof verifiable against the block state root
+func (chain *Blockchain) GetIdentityWithProof(height uint64, addr common.Address) ([]byte, error) {
+	stateDb, err := chain.stateForProof(height)
+	if err != nil {
+		return nil, err
+	}
+	return stateDb.GetIdentityWithProof(addr)
+}
+
+// GetApprovedIdentityWithProof returns the approved identity state of the given block with the proof verifiable
+// against the block identity root
+func (chain *Blockchain) GetApprovedIdentityWithProof(height uint64, addr common.Address) ([]byte, error) {
+	if err := chain.checkProofHeight(height); err != nil {
+		return nil, err
+	}
+	identityStateDb, err := chain.appState.IdentityState.Readonly(height)
+	if err != nil {
+		return nil, err
+	}
+	return identityStateDb.GetIdentityWithProof(addr)
+}
+
+// stateForProof loads the state tree of the given height only, proofs don't need the whole app state
+func (chain *Blockchain) stateForProof(height uint64) (*state.StateDB, error) {
+	if err := chain.checkProofHeight(height); err != nil {
+		return nil, err
+	}
+	return chain.appState.State.Readonly(int64(height))
+}
+
+// checkProofHeight limits proofs to recent states which are not pruned, so serving proofs to peers stays cheap
+func (chain *Blockchain) checkProofHeight(height uint64) error {
+	head := chain.Head.Height()
+	if height > head {
+		return errors.Errorf("block %v is not found", height)
+	}
+	if head-height >= state.MaxSavedStatesCount {
+		return errors.Errorf("state of height %v is too old to provide a proof", height)
+	}
+	return nil
+}
+
+func (chain *Blockchain) readonlyAppState(height uint64) (*appstate.AppState, error) {
+	if height > chain.Head.Height() {
+		return nil, errors.Errorf("block %v is not found", height)
+	}
+	if height == chain.Head.Height() {
+		return chain.appState.Readonly(height)
+	}
+	return chain.appState.ReadonlyHistorical(height)
+}
+
 func (chain *Blockchain) GetTxIndex(hash common.Hash) *types.TransactionIndex {
 	return chain.repo.ReadTxIndex(hash)
 }
@@ -2214,6 +2277,38 @@ func (chain *Blockchain) GetReceipt(hash common.Hash) *types.TxReceipt {
 	return r[idx.Idx]
 }
 
+// GetBlockReceipts returns receipts of contract transactions of the block
+func (chain *Blockchain) GetBlockReceipts(header *types.Header) (types.TxReceipts, error) {
+	if header.ProposedHeader == nil || len(header.ProposedHeader.TxReceiptsCid) == 0 {
+		return nil, nil
+	}
+	data, err := chain.ipfs.Get(header.ProposedHeader.TxReceiptsCid, ipfs.TxReceipt)
+	if err != nil {
+		return nil, err
+	}
+	r := types.TxReceipts{}
+	return r.FromBytes(data), nil
+}
+
+// BlockUsedGas returns gas used by the block transactions including gas used by contracts
+func (chain *Blockchain) BlockUsedGas(block *types.Block) (uint64, error) {
+	if block.IsEmpty() || len(block.Body.Transactions) == 0 {
+		return 0, nil
+	}
+	receipts, err := chain.GetBlockReceipts(block.Header)
+	if err != nil {
+		return 0, err
+	}
+	var usedGas uint64
+	for _, tx := range block.Body.Transactions {
+		usedGas += uint64(fee.CalculateGas(tx))
+	}
+	for _, receipt := range receipts {
+		usedGas += receipt.GasUsed
+	}
+	return usedGas, nil
+}
+
 func (chain *Blockchain) GetTx(hash common.Hash) (*types.Transaction, *types.TransactionIndex) {
 	idx := chain.repo.ReadTxIndex(hash)
 	if idx == nil {
@@ -2494,6 +2589,36 @@ func (chain *Blockchain) IsPermanentCert(header *types.Header) bool {
 		header.Height()%chain.config.Blockchain.StoreCertRange == 0 || header.ProposedHeader != nil && header.ProposedHeader.Upgrade > 0
 }
 
+// RebuildTxIndex saves transactions of stored blocks of the given height range to the transaction index.
+// Blocks whose headers or bodies are missing, e.g. blocks loaded by fast sync, are skipped, their count is returned
+func (chain *Blockchain) RebuildTxIndex(from, to uint64) (skipped int) {
+	if to > chain.Head.Height() {
+		to = chain.Head.Height()
+	}
+	for height := from; height <= to; height++ {
+		if height%1000 == 0 {
+			chain.log.Info("Rebuilding transaction index", "height", height, "to", to, "skipped", skipped)
+		}
+		header := chain.GetBlockHeaderByHeight(height)
+		if header == nil {
+			chain.log.Debug("Block is not found, skip it while rebuilding transaction index", "height", height)
+			skipped++
+			continue
+		}
+		if header.ProposedHeader == nil {
+			continue
+		}
+		block := chain.GetBlock(header.Hash())
+		if block == nil {
+			chain.log.Debug("Block body is not found, skip it while rebuilding transaction index", "height", height)
+			skipped++
+			continue
+		}
+		chain.indexer.IndexBlockTransactions(header, block.Body.Transactions)
+	}
+	return skipped
+}
+
 func (chain *Blockchain) ReadTxs(address common.Address, count int, token []byte) ([]*types.SavedTransaction, []byte) {
 	return chain.repo.GetSavedTxs(address, count, token)
 }
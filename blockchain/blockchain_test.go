@@ -15,6 +15,7 @@ import (
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/tests"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
@@ -398,6 +399,51 @@ func Test_applyNextBlockFee(t *testing.T) {
 	require.Equal(t, new(big.Int).Div(common.DnaBase, big.NewInt(100)), appState.State.FeePerGas())
 }
 
+func TestBlockchain_CalculateNextBlockFeePerGas(t *testing.T) {
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+
+	minFeePerGas := new(big.Int).Div(common.DnaBase, big.NewInt(100))
+	feePerGas := new(big.Int).Div(common.DnaBase, big.NewInt(10))
+
+	require.Equal(t, minFeePerGas, chain.CalculateNextBlockFeePerGas(0, nil, 0))
+	require.Equal(t, minFeePerGas, chain.CalculateNextBlockFeePerGas(0, big.NewInt(1), types.MaxBlockGas/2))
+	require.Equal(t, feePerGas, chain.CalculateNextBlockFeePerGas(0, feePerGas, types.MaxBlockGas/2))
+	// k = 0.25, full block increases fee per gas by 12.5%, empty block decreases it by 12.5%
+	require.Equal(t, big.NewInt(112500000000000000), chain.CalculateNextBlockFeePerGas(0, feePerGas, types.MaxBlockGas))
+	require.Equal(t, big.NewInt(87500000000000000), chain.CalculateNextBlockFeePerGas(0, feePerGas, 0))
+	require.Equal(t, minFeePerGas, chain.CalculateNextBlockFeePerGas(0, new(big.Int).Add(minFeePerGas, big.NewInt(1)), 0))
+
+	networkMinFeePerGas := fee2.GetFeePerGasForNetwork(1000)
+	require.Equal(t, networkMinFeePerGas, chain.CalculateNextBlockFeePerGas(1000, nil, 0))
+	require.True(t, networkMinFeePerGas.Cmp(minFeePerGas) < 0)
+}
+
+func TestBlockchain_BlockUsedGas(t *testing.T) {
+	chain, _ := NewTestBlockchainWithBlocks(0, 0)
+
+	chain.GenerateEmptyBlocks(1)
+	usedGas, err := chain.BlockUsedGas(chain.GetBlock(chain.Head.Hash()))
+	require.NoError(t, err)
+	require.Zero(t, usedGas)
+
+	chain.GenerateBlocks(1, 0)
+	usedGas, err = chain.BlockUsedGas(chain.GetBlock(chain.Head.Hash()))
+	require.NoError(t, err)
+	require.Zero(t, usedGas)
+
+	chain.GenerateBlocks(1, 3)
+	block := chain.GetBlock(chain.Head.Hash())
+	require.Len(t, block.Body.Transactions, 3)
+	var expected uint64
+	for _, tx := range block.Body.Transactions {
+		expected += uint64(fee2.CalculateGas(tx))
+	}
+	usedGas, err = chain.BlockUsedGas(block)
+	require.NoError(t, err)
+	require.Equal(t, expected, usedGas)
+	require.NotZero(t, usedGas)
+}
+
 func Test_applyVrfProposerThreshold(t *testing.T) {
 	chain, _ := NewTestBlockchainWithBlocks(100, 0)
 
@@ -1424,3 +1470,70 @@ func Test_sortAddresses(t *testing.T) {
 		require.Positive(t, bytes.Compare(sortedAddresses[i][:], sortedAddresses[i-1][:]))
 	}
 }
+
+func TestBlockchain_GetAccountWithProof(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, _ := NewCustomTestBlockchain(5, 0, key)
+
+	height := chain.Head.Height()
+	proof, err := chain.GetAccountWithProof(height, addr)
+	require.NoError(t, err)
+	_, err = state.VerifyValueWithProof(chain.GetBlockHeaderByHeight(height).Root(), state.StateDbKeys.AddressKey(addr), proof)
+	require.NoError(t, err)
+
+	proof, err = chain.GetIdentityWithProof(height, addr)
+	require.NoError(t, err)
+	_, err = state.VerifyValueWithProof(chain.GetBlockHeaderByHeight(height).Root(), state.StateDbKeys.IdentityKey(addr), proof)
+	require.NoError(t, err)
+
+	_, err = chain.GetAccountWithProof(height+1, addr)
+	require.Error(t, err)
+
+	chain.GenerateBlocks(state.MaxSavedStatesCount, 0)
+	_, err = chain.GetAccountWithProof(height, addr)
+	require.Error(t, err)
+	_, err = chain.GetApprovedIdentityWithProof(height, addr)
+	require.Error(t, err)
+	_, err = chain.GetAccountWithProof(chain.Head.Height()-state.MaxSavedStatesCount+1, addr)
+	require.NoError(t, err)
+}
+
+func TestBlockchain_RebuildTxIndex(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := NewCustomTestBlockchain(0, 0, key)
+	chain.GenerateBlocks(3, 1)
+	chain.GenerateEmptyBlocks(2)
+
+	head := chain.Head.Height()
+	txsHeight := head - 3
+	chain.repo.RemoveCanonicalHash(txsHeight)
+
+	require.Equal(t, 1, chain.RebuildTxIndex(1, head+10))
+
+	chain.ipfs = ipfs.NewMemoryIpfsProxy()
+	require.Equal(t, 3, chain.RebuildTxIndex(txsHeight-1, head))
+}
+
+func TestBlockchain_consensusAt(t *testing.T) {
+	chain, _ := NewTestBlockchainWithBlocks(5, 0)
+	consensus := *config.ConsensusVersions[config.ConsensusV8]
+	chain.config.Consensus = &consensus
+
+	conf, err := chain.consensusAt(3)
+	require.NoError(t, err)
+	require.Equal(t, config.ConsensusV8, conf.Version)
+
+	upgrade := chain.GetBlockHeaderByHeight(3)
+	upgrade.ProposedHeader.Upgrade = uint32(config.ConsensusV8)
+	chain.repo.WriteBlockHeader(upgrade)
+	chain.repo.WriteCanonicalHash(3, upgrade.Hash())
+	chain.genesisInfo = &types.GenesisInfo{Genesis: chain.GetBlockHeaderByHeight(4), OldGenesis: chain.genesisInfo.Genesis}
+
+	conf, err = chain.consensusAt(3)
+	require.NoError(t, err)
+	require.Equal(t, config.ConsensusV7, conf.Version)
+	conf, err = chain.consensusAt(4)
+	require.NoError(t, err)
+	require.Equal(t, config.ConsensusV8, conf.Version)
+}
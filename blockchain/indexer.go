@@ -9,6 +9,7 @@ import (
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/keystore"
+	"github.com/idena-network/idena-go/vm/env"
 	dbm "github.com/tendermint/tm-db"
 	"sync"
 )
@@ -39,18 +40,55 @@ func (i *indexer) HandleBlockTransactions(header *types.Header, txs []*types.Tra
 
 	i.repo.DeleteOutdatedBurntCoins(header.Height(), i.cfg.Blockchain.BurnTxRange)
 
+	accountsMap := i.ownAccounts()
+
+	for _, tx := range txs {
+		sender, _ := types.Sender(tx)
+		i.handleTx(header, sender, tx, accountsMap)
+		i.handleBurnTx(header.Height(), sender, tx)
+		i.handleOwnDeleteFlipTx(sender, tx)
+	}
+}
+
+// IndexBlockTransactions saves block transactions to the transaction index only, it is used to rebuild the index
+func (i *indexer) IndexBlockTransactions(header *types.Header, txs []*types.Transaction) {
+	accountsMap := i.ownAccounts()
+	for _, tx := range txs {
+		sender, _ := types.Sender(tx)
+		i.handleTx(header, sender, tx, accountsMap)
+	}
+}
+
+func (i *indexer) ownAccounts() map[common.Address]struct{} {
 	accounts := i.keystore.Accounts()
 	accountsMap := make(map[common.Address]struct{})
 	for _, item := range accounts {
 		accountsMap[item.Address] = struct{}{}
 	}
 	accountsMap[i.coinbase] = struct{}{}
+	return accountsMap
+}
 
-	for _, tx := range txs {
-		sender, _ := types.Sender(tx)
-		i.handleOwnTx(header, sender, tx, accountsMap)
-		i.handleBurnTx(header.Height(), sender, tx)
-		i.handleOwnDeleteFlipTx(sender, tx)
+func (i *indexer) handleTx(header *types.Header, sender common.Address, tx *types.Transaction, accountsMap map[common.Address]struct{}) {
+	if i.cfg.Blockchain.IndexAllTxs {
+		i.handleAnyTx(header, sender, tx)
+		return
+	}
+	i.handleOwnTx(header, sender, tx, accountsMap)
+}
+
+// handleAnyTx saves the transaction for its sender, recipient and, in case of contract deployment, for the contract
+func (i *indexer) handleAnyTx(header *types.Header, sender common.Address, tx *types.Transaction) {
+	i.repo.SaveTx(sender, header.Hash(), header.Time(), header.FeePerGas(), tx)
+	var to *common.Address
+	if tx.Type == types.DeployContractTx {
+		contract := env.NewDeployContextImpl(tx, &sender, common.Hash{}).ContractAddr()
+		to = &contract
+	} else {
+		to = tx.To
+	}
+	if to != nil && *to != sender {
+		i.repo.SaveTx(*to, header.Hash(), header.Time(), header.FeePerGas(), tx)
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/tests"
+	"github.com/idena-network/idena-go/vm/env"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
@@ -140,6 +141,55 @@ func Test_handleOwnTxsWithAccounts(t *testing.T) {
 	require.Equal(0, len(data))
 }
 
+func Test_handleAllTxs(t *testing.T) {
+	require := require.New(t)
+
+	chain, _, _, key := NewTestBlockchain(true, nil)
+	chain.config.Blockchain.IndexAllTxs = true
+	key2, _ := crypto.GenerateKey()
+	key3, _ := crypto.GenerateKey()
+
+	addr1 := crypto.PubkeyToAddress(key.PublicKey)
+	addr2 := crypto.PubkeyToAddress(key2.PublicKey)
+	addr3 := crypto.PubkeyToAddress(key3.PublicKey)
+
+	deployTx := tests.GetFullTx(3, 1, key2, types.DeployContractTx, nil, nil, nil)
+	contract := env.NewDeployContextImpl(deployTx, &addr2, common.Hash{}).ContractAddr()
+
+	txs := []txWithTimestamp{
+		{tx: tests.GetFullTx(1, 1, key2, types.SendTx, nil, &addr3, nil), timestamp: 10},
+		{tx: tests.GetFullTx(2, 1, key2, types.SendTx, nil, &addr2, nil), timestamp: 20},
+		{tx: deployTx, timestamp: 30},
+		{tx: tests.GetFullTx(1, 1, key3, types.CallContractTx, nil, &contract, nil), timestamp: 40},
+		{tx: tests.GetFullTx(2, 1, key3, types.BurnTx, big.NewInt(1), nil, attachments.CreateBurnAttachment("1")), timestamp: 50},
+	}
+
+	for _, item := range txs {
+		header := &types.Header{
+			ProposedHeader: &types.ProposedHeader{
+				Time:      item.timestamp,
+				FeePerGas: big.NewInt(1),
+			},
+		}
+		chain.indexer.HandleBlockTransactions(header, []*types.Transaction{item.tx})
+	}
+
+	data, _ := chain.ReadTxs(addr1, 10, nil)
+	require.Equal(0, len(data))
+
+	data, _ = chain.ReadTxs(addr2, 10, nil)
+	require.Equal(3, len(data))
+
+	data, _ = chain.ReadTxs(addr3, 10, nil)
+	require.Equal(3, len(data))
+	require.Equal(types.BurnTx, data[0].Tx.Type)
+
+	data, _ = chain.ReadTxs(contract, 10, nil)
+	require.Equal(2, len(data))
+	require.Equal(types.CallContractTx, data[0].Tx.Type)
+	require.Equal(types.DeployContractTx, data[1].Tx.Type)
+}
+
 func Test_Blockchain_saveBurntCoins(t *testing.T) {
 	require := require.New(t)
 
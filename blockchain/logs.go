@@ -0,0 +1,187 @@
+package blockchain
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/pkg/errors"
+)
+
+const (
+	MaxLogsBlockRange = 10000
+	// MaxUnfilteredLogsBlockRange limits the range of filters without contracts since receipts of every block are read
+	MaxUnfilteredLogsBlockRange = 1000
+)
+
+type LogFilter struct {
+	Contracts []common.Address
+	Events    []string
+	// Args filters events by arg index, an arg matches if it is equal to any of the values
+	Args map[int][][]byte
+}
+
+type Log struct {
+	Contract  common.Address
+	Event     string
+	Args      [][]byte
+	TxHash    common.Hash
+	BlockHash common.Hash
+	Height    uint64
+	// Index is the index of the event among all events of the block
+	Index uint32
+}
+
+// LogPosition is the position to continue logs filtering from
+type LogPosition struct {
+	Height uint64
+	Index  uint32
+}
+
+func (f *LogFilter) match(event *types.TxEvent) bool {
+	if len(f.Contracts) > 0 {
+		found := false
+		for _, c := range f.Contracts {
+			if c == event.Contract {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Events) > 0 {
+		found := false
+		for _, e := range f.Events {
+			if e == event.EventName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for idx, values := range f.Args {
+		if idx < 0 || idx >= len(event.Data) {
+			return false
+		}
+		found := false
+		for _, v := range values {
+			if bytes.Equal(v, event.Data[idx]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomKeys returns values of tx bloom one of which a block should contain to have matching events,
+// nil means that blooms cannot be used for the filter
+func (chain *Blockchain) bloomKeys(filter *LogFilter) [][]byte {
+	if len(filter.Contracts) == 0 {
+		return nil
+	}
+	var keys [][]byte
+	for _, contract := range filter.Contracts {
+		events := filter.Events
+		if len(events) == 0 {
+			// events of contracts are added to blooms with contract addresses, so all events of a known contract are tested,
+			// a contract address itself is added as a call or termination recipient
+			keys = append(keys, contract.Bytes())
+			if codeHash := chain.appState.State.GetCodeHash(contract); codeHash != nil {
+				if descriptor, ok := embedded.Descriptors[*codeHash]; ok {
+					for _, e := range descriptor.Events {
+						events = append(events, e.Name)
+					}
+				}
+			}
+		}
+		for _, e := range events {
+			keys = append(keys, append(contract.Bytes(), []byte(e)...))
+		}
+	}
+	return keys
+}
+
+// FilterLogs returns up to limit events matching the filter from blocks in range [from.Height; to] starting from the event from.Index of the first block.
+// Blocks are skipped by tx blooms of their headers if the filter has contracts, receipts of other blocks are read by receipts cids,
+// so the block range of filters without contracts is limited by MaxUnfilteredLogsBlockRange.
+// The returned position is nil if all blocks of the range are processed.
+func (chain *Blockchain) FilterLogs(filter *LogFilter, from LogPosition, to uint64, limit int) ([]*Log, *LogPosition, error) {
+	if to < from.Height {
+		return nil, nil, errors.New("invalid block range")
+	}
+	keys := chain.bloomKeys(filter)
+	maxRange := uint64(MaxLogsBlockRange)
+	if keys == nil {
+		maxRange = MaxUnfilteredLogsBlockRange
+	}
+	if to-from.Height >= maxRange {
+		return nil, nil, errors.Errorf("block range should not exceed %v blocks", maxRange)
+	}
+	if to > chain.Head.Height() {
+		to = chain.Head.Height()
+	}
+	var logs []*Log
+	for height := from.Height; height <= to; height++ {
+		header := chain.GetBlockHeaderByHeight(height)
+		if header == nil || header.ProposedHeader == nil || len(header.ProposedHeader.TxReceiptsCid) == 0 {
+			continue
+		}
+		if keys != nil {
+			if len(header.ProposedHeader.TxBloom) == 0 {
+				continue
+			}
+			bloom, err := common.NewSerializableBFFromData(header.ProposedHeader.TxBloom)
+			if err != nil {
+				return nil, nil, err
+			}
+			found := false
+			for _, key := range keys {
+				if bloom.Has(key) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		receipts, err := chain.GetBlockReceipts(header)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to read receipts of block %v", height)
+		}
+		var index uint32
+		for _, r := range receipts {
+			for _, e := range r.Events {
+				eventIndex := index
+				index++
+				if height == from.Height && eventIndex < from.Index {
+					continue
+				}
+				if !filter.match(e) {
+					continue
+				}
+				if len(logs) == limit {
+					return logs, &LogPosition{Height: height, Index: eventIndex}, nil
+				}
+				logs = append(logs, &Log{
+					Contract:  e.Contract,
+					Event:     e.EventName,
+					Args:      e.Data,
+					TxHash:    r.TxHash,
+					BlockHash: header.Hash(),
+					Height:    height,
+					Index:     eventIndex,
+				})
+			}
+		}
+	}
+	return logs, nil, nil
+}
@@ -0,0 +1,162 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLogFilter_match(t *testing.T) {
+	contract := common.Address{0x1}
+	event := &types.TxEvent{Contract: contract, EventName: "transfer", Data: [][]byte{{0x1}, {0x2}}}
+
+	require.True(t, (&LogFilter{}).match(event))
+	require.True(t, (&LogFilter{Contracts: []common.Address{{0x2}, contract}}).match(event))
+	require.False(t, (&LogFilter{Contracts: []common.Address{{0x2}}}).match(event))
+	require.True(t, (&LogFilter{Events: []string{"transfer"}}).match(event))
+	require.False(t, (&LogFilter{Events: []string{"approval"}}).match(event))
+	require.True(t, (&LogFilter{Args: map[int][][]byte{1: {{0x3}, {0x2}}}}).match(event))
+	require.False(t, (&LogFilter{Args: map[int][][]byte{0: {{0x2}}}}).match(event))
+	require.False(t, (&LogFilter{Args: map[int][][]byte{2: {{0x2}}}}).match(event))
+}
+
+func TestBlockchain_bloomKeys(t *testing.T) {
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+	key, _ := crypto.GenerateKey()
+	contract := common.Address{0x1}
+	tx, _ := types.SignTx(&types.Transaction{Type: types.CallContractTx, To: &contract}, key)
+	block := &types.Block{
+		Header: &types.Header{ProposedHeader: &types.ProposedHeader{}},
+		Body:   &types.Body{Transactions: []*types.Transaction{tx}},
+	}
+	callee := common.Address{0x3}
+	receipts := types.TxReceipts{{ContractAddress: contract, Events: []*types.TxEvent{
+		{Contract: contract, EventName: "transfer"},
+		{Contract: callee, EventName: "approval"},
+	}}}
+	bloom, err := common.NewSerializableBFFromData(calculateTxBloom(block, receipts))
+	require.NoError(t, err)
+
+	has := func(filter *LogFilter) bool {
+		for _, key := range chain.bloomKeys(filter) {
+			if bloom.Has(key) {
+				return true
+			}
+		}
+		return false
+	}
+	require.Nil(t, chain.bloomKeys(&LogFilter{Events: []string{"transfer"}}))
+	require.True(t, has(&LogFilter{Contracts: []common.Address{contract}}))
+	require.True(t, has(&LogFilter{Contracts: []common.Address{contract}, Events: []string{"transfer"}}))
+	require.False(t, has(&LogFilter{Contracts: []common.Address{contract}, Events: []string{"approval"}}))
+	require.True(t, has(&LogFilter{Contracts: []common.Address{callee}, Events: []string{"approval"}}))
+	require.False(t, has(&LogFilter{Contracts: []common.Address{callee}, Events: []string{"transfer"}}))
+	require.True(t, has(&LogFilter{Contracts: []common.Address{callee}}))
+	require.False(t, has(&LogFilter{Contracts: []common.Address{{0x2}}}))
+}
+
+func TestBlockchain_FilterLogs(t *testing.T) {
+	chain, _ := NewTestBlockchainWithBlocks(0, 6)
+	key, _ := crypto.GenerateKey()
+	contract, otherContract := common.Address{0x1}, common.Address{0x2}
+
+	// blocks 2 and 4 have 3 events, block 3 has no receipts, block 5 has an event of other contract
+	// and an event emitted by the contract called from other contract
+	writeBlock := func(height uint64, receipts types.TxReceipts) {
+		header := &types.Header{ProposedHeader: &types.ProposedHeader{Height: height}}
+		if len(receipts) > 0 {
+			data, _ := receipts.ToBytes()
+			cid, err := chain.ipfs.Add(data, false)
+			require.NoError(t, err)
+			tx, _ := types.SignTx(&types.Transaction{Type: types.CallContractTx, To: &receipts[0].ContractAddress}, key)
+			block := &types.Block{Header: header, Body: &types.Body{Transactions: []*types.Transaction{tx}}}
+			header.ProposedHeader.TxReceiptsCid = cid.Bytes()
+			header.ProposedHeader.TxBloom = calculateTxBloom(block, receipts)
+		}
+		chain.repo.WriteBlockHeader(header)
+		chain.repo.WriteCanonicalHash(height, header.Hash())
+	}
+	events := func(contract common.Address, names ...string) []*types.TxEvent {
+		var res []*types.TxEvent
+		for _, name := range names {
+			res = append(res, &types.TxEvent{Contract: contract, EventName: name})
+		}
+		return res
+	}
+	writeBlock(2, types.TxReceipts{
+		{ContractAddress: contract, Events: events(contract, "a", "b")},
+		{ContractAddress: contract, Events: events(contract, "c")},
+	})
+	writeBlock(3, nil)
+	writeBlock(4, types.TxReceipts{{ContractAddress: contract, Events: events(contract, "d", "e", "f")}})
+	writeBlock(5, types.TxReceipts{{ContractAddress: otherContract, Events: append(events(otherContract, "g"), events(contract, "h")...)}})
+	filter := &LogFilter{Contracts: []common.Address{contract}}
+
+	names := func(logs []*Log) []string {
+		var res []string
+		for _, l := range logs {
+			res = append(res, l.Event)
+		}
+		return res
+	}
+
+	logs, next, err := chain.FilterLogs(filter, LogPosition{Height: 1}, chain.Head.Height(), 10)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Equal(t, []string{"a", "b", "c", "d", "e", "f", "h"}, names(logs))
+	require.Equal(t, uint32(2), logs[2].Index)
+	require.Equal(t, uint64(4), logs[3].Height)
+
+	// limit is reached in the middle of a block
+	logs, next, err = chain.FilterLogs(filter, LogPosition{Height: 1}, chain.Head.Height(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, names(logs))
+	require.Equal(t, &LogPosition{Height: 2, Index: 2}, next)
+
+	logs, next, err = chain.FilterLogs(filter, *next, chain.Head.Height(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, names(logs))
+	require.Equal(t, &LogPosition{Height: 4, Index: 1}, next)
+
+	logs, next, err = chain.FilterLogs(filter, *next, chain.Head.Height(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"e", "f"}, names(logs))
+	require.Equal(t, &LogPosition{Height: 5, Index: 1}, next)
+
+	logs, next, err = chain.FilterLogs(filter, *next, chain.Head.Height(), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"h"}, names(logs))
+	require.Equal(t, contract, logs[0].Contract)
+	require.Nil(t, next)
+
+	// limit is reached exactly at the block boundary, so the next position points to the next matching event
+	logs, next, err = chain.FilterLogs(filter, LogPosition{Height: 1}, chain.Head.Height(), 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, names(logs))
+	require.Equal(t, &LogPosition{Height: 4, Index: 0}, next)
+
+	logs, next, err = chain.FilterLogs(filter, *next, chain.Head.Height(), 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "e", "f"}, names(logs))
+	require.Equal(t, &LogPosition{Height: 5, Index: 1}, next)
+
+	// filter without contracts reads receipts of every block
+	logs, next, err = chain.FilterLogs(&LogFilter{Events: []string{"c", "g"}}, LogPosition{Height: 3}, chain.Head.Height(), 10)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Equal(t, []string{"g"}, names(logs))
+	require.Equal(t, otherContract, logs[0].Contract)
+
+	_, _, err = chain.FilterLogs(filter, LogPosition{Height: 5}, 4, 10)
+	require.Error(t, err)
+	_, _, err = chain.FilterLogs(filter, LogPosition{Height: 1}, MaxUnfilteredLogsBlockRange+1, 10)
+	require.NoError(t, err)
+	_, _, err = chain.FilterLogs(&LogFilter{}, LogPosition{Height: 1}, MaxUnfilteredLogsBlockRange, 10)
+	require.NoError(t, err)
+	_, _, err = chain.FilterLogs(&LogFilter{}, LogPosition{Height: 1}, MaxUnfilteredLogsBlockRange+1, 10)
+	require.Error(t, err)
+	_, _, err = chain.FilterLogs(filter, LogPosition{Height: 1}, MaxLogsBlockRange+1, 10)
+	require.Error(t, err)
+}
@@ -0,0 +1,258 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+// RewardAssumptions describe a hypothetical validation outcome of an identity.
+// Other identities are assumed to keep their states, candidates to become newbies and flips to get NetworkFlipGrade.
+type RewardAssumptions struct {
+	// State is the identity state after the validation
+	State      state.IdentityState
+	BadAuthor  bool
+	FlipGrades []types.Grade
+	// Invitees are validated invitees of the identity, all current invitees are assumed to be validated if nil
+	Invitees []common.Address
+	// Reports is the number of rewarded reports of the identity
+	Reports int
+	// NetworkReports is the number of rewarded reports of other identities
+	NetworkReports   int
+	NetworkFlipGrade types.Grade
+}
+
+type EstimatedReward struct {
+	Balance *big.Int
+	Stake   *big.Int
+}
+
+// rewardsEstimateCollector collects rewards by identity the reward stake is paid to
+type rewardsEstimateCollector struct {
+	collector.StatsCollector
+	rewards map[common.Address]map[string]*EstimatedReward
+}
+
+func (c *rewardsEstimateCollector) add(rewardType string, stakeDest common.Address, balance, stake *big.Int) {
+	rewards, ok := c.rewards[stakeDest]
+	if !ok {
+		rewards = make(map[string]*EstimatedReward)
+		c.rewards[stakeDest] = rewards
+	}
+	reward, ok := rewards[rewardType]
+	if !ok {
+		reward = &EstimatedReward{Balance: new(big.Int), Stake: new(big.Int)}
+		rewards[rewardType] = reward
+	}
+	reward.Balance.Add(reward.Balance, balance)
+	reward.Stake.Add(reward.Stake, stake)
+}
+
+func (c *rewardsEstimateCollector) AddValidationReward(balanceDest, stakeDest common.Address, age uint16, balance, stake *big.Int) {
+	c.add(collector.RewardValidation, stakeDest, balance, stake)
+}
+
+func (c *rewardsEstimateCollector) AddCandidateReward(balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	c.add(collector.RewardCandidate, stakeDest, balance, stake)
+}
+
+func (c *rewardsEstimateCollector) AddStakingReward(balanceDest, stakeDest common.Address, stakedAmount *big.Int, balance, stake *big.Int) {
+	c.add(collector.RewardStaking, stakeDest, balance, stake)
+}
+
+func (c *rewardsEstimateCollector) AddFlipsReward(balanceDest, stakeDest common.Address, balance, stake *big.Int, flipsToReward []*types.FlipToReward) {
+	c.add(collector.RewardFlips, stakeDest, balance, stake)
+}
+
+func (c *rewardsEstimateCollector) AddReportedFlipsReward(balanceDest, stakeDest common.Address, shardId common.ShardId, flipIdx int, balance, stake *big.Int) {
+	c.add(collector.RewardReports, stakeDest, balance, stake)
+}
+
+func (c *rewardsEstimateCollector) AddInvitationsReward(balanceDest, stakeDest common.Address, balance, stake *big.Int, age uint16, txHash *common.Hash,
+	epochHeight uint32, isSavedInviteWinner bool) {
+	c.add(collector.RewardInvitations, stakeDest, balance, stake)
+}
+
+// EstimateRewards applies epoch rewards to the app state for the hypothetical validation outcome and returns rewards
+// of the identity by type. The app state is modified so a state for check should be passed.
+func EstimateRewards(appState *appstate.AppState, conf *config.ConsensusConf, addr common.Address, assumptions *RewardAssumptions,
+	epochDurations []uint32) (map[string]*EstimatedReward, error) {
+	identity := appState.State.GetIdentity(addr)
+	if !identity.State.IsInShard() {
+		return nil, errors.New("identity is not validating")
+	}
+	switch assumptions.State {
+	case state.Newbie, state.Verified, state.Human, state.Suspended, state.Zombie, state.Killed, state.Undefined:
+	default:
+		return nil, errors.New("invalid identity state after validation")
+	}
+	if identity.State == state.Candidate && assumptions.State != state.Newbie && assumptions.State != state.Killed &&
+		assumptions.State != state.Undefined {
+		return nil, errors.New("candidate can only become newbie")
+	}
+
+	epoch := appState.State.Epoch()
+	validationResults := make(map[common.ShardId]*types.ValidationResults)
+	shardResults := func(shardId common.ShardId) *types.ValidationResults {
+		res, ok := validationResults[shardId]
+		if !ok {
+			res = &types.ValidationResults{
+				BadAuthors:              map[common.Address]types.BadAuthorReason{},
+				GoodAuthors:             map[common.Address]*types.ValidationResult{},
+				AuthorResults:           map[common.Address]*types.AuthorResults{},
+				GoodInviters:            map[common.Address]*types.InviterValidationResult{},
+				ReportersToRewardByFlip: map[int]map[common.Address]*types.Candidate{},
+			}
+			validationResults[shardId] = res
+		}
+		return res
+	}
+	for i := uint32(1); i <= appState.State.ShardsNum(); i++ {
+		shardResults(common.ShardId(i))
+	}
+
+	validatedInvitees := make(map[common.Address]struct{})
+	if assumptions.Invitees != nil {
+		for _, invitee := range assumptions.Invitees {
+			validatedInvitees[invitee] = struct{}{}
+		}
+	}
+
+	type identityOutcome struct {
+		addr     common.Address
+		identity state.Identity
+		newState state.IdentityState
+	}
+	var outcomes []identityOutcome
+	newStates := make(map[common.Address]state.IdentityState)
+	shards := make(map[common.Address]common.ShardId)
+	appState.State.IterateOverIdentities(func(identityAddr common.Address, identity state.Identity) {
+		newState := identity.State
+		switch {
+		case identityAddr == addr:
+			newState = assumptions.State
+		case identity.State == state.Candidate:
+			newState = state.Newbie
+		case identity.Inviter != nil && identity.Inviter.Address == addr && assumptions.Invitees != nil:
+			if _, ok := validatedInvitees[identityAddr]; !ok {
+				newState = state.Killed
+			}
+		}
+		newStates[identityAddr] = newState
+		shards[identityAddr] = identity.ShiftedShardId()
+		outcomes = append(outcomes, identityOutcome{identityAddr, identity, newState})
+	})
+
+	for _, outcome := range outcomes {
+		results := shardResults(outcome.identity.ShiftedShardId())
+		if outcome.addr == addr {
+			if assumptions.BadAuthor {
+				results.BadAuthors[addr] = types.NoQualifiedFlipsBadAuthor
+			} else if len(assumptions.FlipGrades) > 0 && outcome.newState.NewbieOrBetter() {
+				flips := make([]*types.FlipToReward, 0, len(assumptions.FlipGrades))
+				for _, grade := range assumptions.FlipGrades {
+					flips = append(flips, &types.FlipToReward{Grade: grade})
+				}
+				results.GoodAuthors[addr] = &types.ValidationResult{FlipsToReward: flips, NewIdentityState: uint8(outcome.newState)}
+			}
+			if outcome.newState.NewbieOrBetter() {
+				for i := 0; i < assumptions.Reports; i++ {
+					results.ReportersToRewardByFlip[i] = map[common.Address]*types.Candidate{
+						addr: {Address: addr, NewIdentityState: uint8(outcome.newState)},
+					}
+				}
+			}
+		} else if len(outcome.identity.Flips) > 0 && outcome.newState.NewbieOrBetter() {
+			flips := make([]*types.FlipToReward, 0, len(outcome.identity.Flips))
+			for range outcome.identity.Flips {
+				flips = append(flips, &types.FlipToReward{Grade: assumptions.NetworkFlipGrade})
+			}
+			results.GoodAuthors[outcome.addr] = &types.ValidationResult{FlipsToReward: flips, NewIdentityState: uint8(outcome.newState)}
+		}
+
+		if outcome.identity.Inviter == nil || outcome.newState != state.Newbie && outcome.newState != state.Verified {
+			continue
+		}
+		age := epoch - outcome.identity.Birthday + 1
+		if outcome.identity.State == state.Candidate {
+			age = 1
+		}
+		if age > 3 {
+			continue
+		}
+		inviterAddr := outcome.identity.Inviter.Address
+		inviterState, ok := newStates[inviterAddr]
+		if !ok {
+			continue
+		}
+		inviterResults := shardResults(shards[inviterAddr])
+		inviter, ok := inviterResults.GoodInviters[inviterAddr]
+		if !ok {
+			inviter = &types.InviterValidationResult{
+				NewIdentityState:    uint8(inviterState),
+				PayInvitationReward: inviterState.NewbieOrBetter() && !(inviterAddr == addr && assumptions.BadAuthor),
+			}
+			inviterResults.GoodInviters[inviterAddr] = inviter
+		}
+		inviter.SuccessfulInvites = append(inviter.SuccessfulInvites, &types.SuccessfulInvite{
+			Age:         age,
+			TxHash:      outcome.identity.Inviter.TxHash,
+			EpochHeight: outcome.identity.Inviter.EpochHeight,
+		})
+	}
+
+	// reports of other identities share the reports reward with the identity
+	otherReporter := common.Address{0x1}
+	if otherReporter == addr {
+		otherReporter = common.Address{0x2}
+	}
+	for i := 0; i < assumptions.NetworkReports; i++ {
+		shardResults(common.ShardId(1)).ReportersToRewardByFlip[assumptions.Reports+i] = map[common.Address]*types.Candidate{
+			otherReporter: {Address: otherReporter, NewIdentityState: uint8(state.Verified)},
+		}
+	}
+
+	for _, outcome := range outcomes {
+		if outcome.newState == outcome.identity.State {
+			continue
+		}
+		appState.State.SetState(outcome.addr, outcome.newState)
+		if outcome.identity.State == state.Candidate && outcome.newState == state.Newbie {
+			appState.State.SetBirthday(outcome.addr, epoch)
+		}
+	}
+
+	rewards := CalculateValidationRewards(appState, conf, validationResults, epochDurations)[addr]
+	if rewards == nil {
+		rewards = make(map[string]*EstimatedReward)
+	}
+	return rewards, nil
+}
+
+// CalculateValidationRewards applies epoch rewards of the validation results to the app state and returns rewards
+// by identity and reward type
+func CalculateValidationRewards(appState *appstate.AppState, conf *config.ConsensusConf, validationResults map[common.ShardId]*types.ValidationResults,
+	epochDurations []uint32) map[common.Address]map[string]*EstimatedReward {
+	statsCollector := &rewardsEstimateCollector{
+		StatsCollector: collector.NewStatsCollector(),
+		rewards:        make(map[common.Address]map[string]*EstimatedReward),
+	}
+	rewardValidIdentities(appState, conf, validationResults, epochDurations, statsCollector)
+	return statsCollector.rewards
+}
+
+// EpochDurations returns durations of previous epochs and of the current epoch if the validation finishes at the height
+func EpochDurations(appState *appstate.AppState, height uint64) []uint32 {
+	prevEpochBlocks := appState.State.PrevEpochBlocks()
+	epochBlocks := append(prevEpochBlocks, []uint64{appState.State.EpochBlock(), height}...)
+	durations := make([]uint32, 0, len(epochBlocks)-1)
+	for i := 0; i < len(epochBlocks)-1; i++ {
+		durations = append(durations, uint32(epochBlocks[i+1]-epochBlocks[i]))
+	}
+	return durations
+}
@@ -0,0 +1,110 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func TestEstimateRewards(t *testing.T) {
+	addr := common.Address{0x2}
+	other := common.Address{0x3}
+	invitee := common.Address{0x4}
+
+	conf := config.GetDefaultConsensusConfig()
+	conf.EnableUpgrade7 = true
+	conf.EnableUpgrade8 = true
+
+	newAppState := func() *appstate.AppState {
+		appState, _ := appstate.NewAppState(db.NewMemDB(), eventbus.New())
+		appState.Initialize(0)
+		appState.State.SetGlobalEpoch(5)
+		appState.State.SetGodAddress(common.Address{0x1})
+		appState.State.SetShardsNum(1)
+		appState.State.SetState(addr, state.Verified)
+		appState.State.SetBirthday(addr, 2)
+		appState.State.AddStake(addr, common.DnaBase)
+		appState.State.SetState(other, state.Newbie)
+		appState.State.SetBirthday(other, 4)
+		appState.State.AddStake(other, common.DnaBase)
+		appState.State.AddFlip(other, []byte{0x1}, 0)
+		appState.State.SetState(invitee, state.Candidate)
+		appState.State.SetInviter(invitee, addr, common.Hash{0x1}, 10)
+		appState.State.AddInvitee(addr, invitee, common.Hash{0x1})
+		appState.Commit(nil, true)
+		return appState
+	}
+	epochDurations := []uint32{100}
+
+	rewards, err := EstimateRewards(newAppState(), conf, addr, &RewardAssumptions{
+		State:            state.Verified,
+		FlipGrades:       []types.Grade{types.GradeA, types.GradeB},
+		Reports:          1,
+		NetworkReports:   1,
+		NetworkFlipGrade: types.GradeC,
+	}, epochDurations)
+	require.NoError(t, err)
+	require.Len(t, rewards, 4)
+
+	totalReward := decimal.NewFromBigInt(new(big.Int).Mul(new(big.Int).Add(conf.BlockReward, conf.FinalCommitteeReward), big.NewInt(100)), 0)
+	total := func(rewardType string) *big.Int {
+		return new(big.Int).Add(rewards[rewardType].Balance, rewards[rewardType].Stake)
+	}
+	// flips of the identity weigh 8 + 4, the flip of the other identity weighs 2
+	flipShare := totalReward.Mul(decimal.NewFromFloat32(conf.FlipRewardPercent)).Div(decimal.NewFromFloat32(14))
+	require.Equal(t, math.ToInt(flipShare.Mul(decimal.NewFromFloat32(12))), total(collector.RewardFlips))
+	// balance and stake parts of rewards are rounded separately
+	requireRounded := func(expected, actual *big.Int) {
+		require.True(t, new(big.Int).Sub(expected, actual).CmpAbs(big.NewInt(1)) <= 0, "expected %v, actual %v", expected, actual)
+	}
+	// the reward is shared with the other reporter
+	reportShare := totalReward.Mul(decimal.NewFromFloat32(conf.ReportsRewardPercent)).Div(decimal.NewFromInt(2))
+	requireRounded(math.ToInt(reportShare), total(collector.RewardReports))
+	// the identity is the only inviter
+	requireRounded(math.ToInt(totalReward.Mul(decimal.NewFromFloat32(conf.ValidInvitationRewardPercent))), total(collector.RewardInvitations))
+	// stakes of both identities are equal
+	stakingReward := math.ToInt(totalReward.Mul(decimal.NewFromFloat32(conf.StakingRewardPercent)).Div(decimal.NewFromInt(2)))
+	require.True(t, new(big.Int).Sub(stakingReward, total(collector.RewardStaking)).CmpAbs(big.NewInt(1000)) <= 0)
+
+	rewards, err = EstimateRewards(newAppState(), conf, addr, &RewardAssumptions{
+		State:      state.Verified,
+		BadAuthor:  true,
+		FlipGrades: []types.Grade{types.GradeA},
+	}, epochDurations)
+	require.NoError(t, err)
+	require.Empty(t, rewards)
+
+	rewards, err = EstimateRewards(newAppState(), conf, addr, &RewardAssumptions{
+		State:    state.Suspended,
+		Invitees: []common.Address{},
+	}, epochDurations)
+	require.NoError(t, err)
+	require.Empty(t, rewards)
+
+	rewards, err = EstimateRewards(newAppState(), conf, invitee, &RewardAssumptions{State: state.Newbie}, epochDurations)
+	require.NoError(t, err)
+	require.Equal(t, []string{collector.RewardCandidate}, keys(rewards))
+
+	_, err = EstimateRewards(newAppState(), conf, invitee, &RewardAssumptions{State: state.Verified}, epochDurations)
+	require.Error(t, err)
+	_, err = EstimateRewards(newAppState(), conf, common.Address{0x5}, &RewardAssumptions{State: state.Newbie}, epochDurations)
+	require.Error(t, err)
+}
+
+func keys(rewards map[string]*EstimatedReward) []string {
+	var res []string
+	for rewardType := range rewards {
+		res = append(res, rewardType)
+	}
+	return res
+}
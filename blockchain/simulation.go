@@ -0,0 +1,101 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/fee"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/vm"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+type BalanceDelta struct {
+	Address common.Address
+	Balance *big.Int
+	Stake   *big.Int
+}
+
+type SimulatedTx struct {
+	Tx        *types.Transaction
+	Fee       *big.Int
+	Receipt   *types.TxReceipt
+	Deltas    []*BalanceDelta
+	StateDiff []*state.StateTreeDiff
+	Err       error
+}
+
+// SimulateTxs applies transactions one by one on a throwaway copy of the state at the given height.
+// Transactions failed validation are skipped, so the next ones are applied on the state left by the last successful transaction.
+// Fee per gas of the state is returned along with the results.
+func (chain *Blockchain) SimulateTxs(height uint64, txs []*types.Transaction) ([]*SimulatedTx, *big.Int, error) {
+	header := chain.GetBlockHeaderByHeight(height)
+	if header == nil {
+		return nil, nil, errors.Errorf("block %v is not found", height)
+	}
+	base, err := chain.readonlyAppState(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	appState, err := chain.appState.ForCheck(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	minFeePerGas := fee.GetFeePerGasForNetwork(appState.ValidatorsCache.NetworkSize())
+	vm := vm.NewVmImpl(appState, header, nil, chain.config)
+
+	type balances struct {
+		balance, stake *big.Int
+	}
+	known := make(map[common.Address]balances)
+
+	result := make([]*SimulatedTx, 0, len(txs))
+	for _, tx := range txs {
+		simulated := &SimulatedTx{Tx: tx}
+		result = append(result, simulated)
+		if err := validation.ValidateTx(appState, tx, minFeePerGas, validation.InBlockTx); err != nil {
+			simulated.Err = err
+			continue
+		}
+		context := &txExecutionContext{appState: appState, vm: vm, height: height + 1}
+		usedFee, receipt, _, err := chain.applyTxOnState(tx, context)
+		if err != nil {
+			simulated.Err = err
+			continue
+		}
+		if receipt != nil {
+			receipt.GasCost = chain.GetGasCost(appState, receipt.GasUsed)
+		}
+		simulated.Fee = usedFee
+		simulated.Receipt = receipt
+		simulated.StateDiff = appState.State.Precommit(true)
+
+		touched := make(map[common.Address]struct{})
+		for _, item := range simulated.StateDiff {
+			addr, ok := state.StateDbKeys.AddressFromKey(item.Key)
+			if !ok {
+				continue
+			}
+			if _, ok := touched[addr]; ok {
+				continue
+			}
+			touched[addr] = struct{}{}
+			prev, ok := known[addr]
+			if !ok {
+				prev = balances{base.State.GetBalance(addr), base.State.GetStakeBalance(addr)}
+			}
+			current := balances{appState.State.GetBalance(addr), appState.State.GetStakeBalance(addr)}
+			known[addr] = current
+			delta := &BalanceDelta{
+				Address: addr,
+				Balance: new(big.Int).Sub(current.balance, prev.balance),
+				Stake:   new(big.Int).Sub(current.stake, prev.stake),
+			}
+			if delta.Balance.Sign() != 0 || delta.Stake.Sign() != 0 {
+				simulated.Deltas = append(simulated.Deltas, delta)
+			}
+		}
+	}
+	return result, base.State.FeePerGas(), nil
+}
@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func Test_SimulateTxs(t *testing.T) {
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	balance := new(big.Int).Mul(common.DnaBase, big.NewInt(100))
+	alloc := map[common.Address]config.GenesisAllocation{
+		sender: {Balance: balance},
+	}
+	chain, appState, _, _ := NewTestBlockchain(true, alloc)
+	recipient := common.Address{0x1}
+
+	buildTx := func(nonce uint32, amount int64, useRlp bool) *types.Transaction {
+		tx := &types.Transaction{
+			Type:         types.SendTx,
+			AccountNonce: nonce,
+			To:           &recipient,
+			Amount:       new(big.Int).Mul(common.DnaBase, big.NewInt(amount)),
+			MaxFee:       new(big.Int).Mul(common.DnaBase, big.NewInt(20)),
+			UseRlp:       useRlp,
+		}
+		signedTx, _ := types.SignTx(tx, senderKey)
+		return signedTx
+	}
+
+	result, feePerGas, err := chain.SimulateTxs(chain.Head.Height(), []*types.Transaction{buildTx(1, 10, false), buildTx(3, 1, false), buildTx(2, 5, true)})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.Equal(t, appState.State.FeePerGas(), feePerGas)
+
+	require.NoError(t, result[0].Err)
+	require.NotEmpty(t, result[0].StateDiff)
+	require.Len(t, result[0].Deltas, 2)
+	for _, delta := range result[0].Deltas {
+		if delta.Address == recipient {
+			require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(10)), delta.Balance)
+		} else {
+			require.Equal(t, sender, delta.Address)
+			require.Equal(t, new(big.Int).Neg(new(big.Int).Add(new(big.Int).Mul(common.DnaBase, big.NewInt(10)), result[0].Fee)), delta.Balance)
+		}
+	}
+
+	require.Error(t, result[1].Err)
+	require.Nil(t, result[1].Deltas)
+
+	require.NoError(t, result[2].Err)
+	for _, delta := range result[2].Deltas {
+		if delta.Address == recipient {
+			require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(5)), delta.Balance)
+		}
+	}
+
+	require.Equal(t, balance, appState.State.GetBalance(sender))
+	require.Zero(t, appState.State.GetBalance(recipient).Sign())
+}
@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/pkg/errors"
+)
+
+type TracedTx struct {
+	Tx *types.Transaction
+	// Header is the header of the block containing the transaction
+	Header  *types.Header
+	Receipt *types.TxReceipt
+	Trace   *env.Trace
+}
+
+// TraceTx re-executes the contract transaction on the state of the parent block with preceding transactions of its block applied
+// and with the consensus config of the block, env operations of the contract execution are recorded to the returned trace
+func (chain *Blockchain) TraceTx(hash common.Hash) (*TracedTx, error) {
+	tx, idx := chain.GetTx(hash)
+	if tx == nil {
+		return nil, errors.New("transaction is not found")
+	}
+	if tx.Type != types.CallContractTx && tx.Type != types.DeployContractTx && tx.Type != types.TerminateContractTx {
+		return nil, errors.New("transaction is not a contract transaction")
+	}
+	block := chain.GetBlock(idx.BlockHash)
+	if block == nil {
+		return nil, errors.Errorf("block %v is not found", idx.BlockHash.Hex())
+	}
+	consensus, err := chain.consensusAt(block.Height())
+	if err != nil {
+		return nil, err
+	}
+	cfg := *chain.config
+	cfg.Consensus = consensus
+	appState, err := chain.appState.ForCheck(block.Height() - 1)
+	if err != nil {
+		return nil, err
+	}
+	context := &txExecutionContext{
+		appState: appState,
+		vm:       vm.NewVmImpl(appState, block.Header, nil, &cfg),
+		height:   block.Height(),
+	}
+	for _, prev := range block.Body.Transactions[:idx.Idx] {
+		if _, _, _, err := chain.applyTxOnState(prev, context); err != nil {
+			return nil, errors.Wrapf(err, "failed to apply transaction %v", prev.Hash().Hex())
+		}
+	}
+	trace := new(env.Trace)
+	context.vm = vm.NewTracingVmImpl(appState, block.Header, &cfg, trace)
+	_, receipt, _, err := chain.applyTxOnState(tx, context)
+	if err != nil {
+		return nil, err
+	}
+	return &TracedTx{Tx: tx, Header: block.Header, Receipt: receipt, Trace: trace}, nil
+}
+
+// consensusAt returns the consensus config the block of the height was applied with. The config of the chain is applied
+// after the upgrade block of its version, which precedes the intermediate genesis, earlier blocks are applied with the previous version.
+// States of blocks before the previous upgrade aren't kept, so older versions aren't resolved.
+func (chain *Blockchain) consensusAt(height uint64) (*config.ConsensusConf, error) {
+	consensus := chain.config.Consensus
+	if height > chain.lastUpgradeHeight() {
+		return consensus, nil
+	}
+	prev, ok := config.ConsensusVersions[consensus.Version-1]
+	if !ok {
+		return nil, errors.Errorf("consensus config of block %v is unknown", height)
+	}
+	return prev, nil
+}
+
+// lastUpgradeHeight returns the height of the block which upgraded the chain to the current consensus version or 0 if it isn't known
+func (chain *Blockchain) lastUpgradeHeight() uint64 {
+	if chain.genesisInfo == nil || chain.genesisInfo.OldGenesis == nil {
+		return 0
+	}
+	header := chain.GetBlockHeaderByHeight(chain.genesisInfo.Genesis.Height() - 1)
+	if header == nil || header.ProposedHeader == nil || header.ProposedHeader.Upgrade != uint32(chain.config.Consensus.Version) {
+		return 0
+	}
+	return header.Height()
+}
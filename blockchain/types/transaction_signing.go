@@ -8,9 +8,14 @@ import (
 	"github.com/idena-network/idena-go/rlp"
 )
 
-// SignTx returns transaction signed with given private key
+// SignTx returns transaction signed with given private key, rlp encoded transactions are signed over the rlp hash
 func SignTx(tx *Transaction, prv *ecdsa.PrivateKey) (*Transaction, error) {
-	h := crypto.SignatureHash(tx)
+	var h common.Hash
+	if tx.UseRlp {
+		h = signatureHash(tx)
+	} else {
+		h = crypto.SignatureHash(tx)
+	}
 	sig, err := crypto.Sign(h[:], prv)
 	if err != nil {
 		return nil, err
@@ -26,6 +31,7 @@ func SignTx(tx *Transaction, prv *ecdsa.PrivateKey) (*Transaction, error) {
 		To:           tx.To,
 		Type:         tx.Type,
 		Signature:    sig,
+		UseRlp:       tx.UseRlp,
 	}, nil
 }
 
@@ -1466,6 +1466,8 @@ func (i *TransactionIndex) FromBytes(data []byte) error {
 }
 
 type TxEvent struct {
+	// Contract is the contract emitted the event, it differs from the receipt contract for events of called contracts
+	Contract  common.Address
 	EventName string
 	Data      [][]byte
 }
@@ -1527,10 +1529,15 @@ func (r *TxReceipt) ToProto() *models.ProtoTxReceipts_ProtoTxReceipt {
 	}
 	for idx := range r.Events {
 		e := r.Events[idx]
-		protoObj.Events = append(protoObj.Events, &models.ProtoTxReceipts_ProtoEvent{
+		protoEvent := &models.ProtoTxReceipts_ProtoEvent{
 			Event: e.EventName,
 			Data:  e.Data,
-		})
+		}
+		// the contract is stored for events of called contracts only so receipts without contract calls keep their encoding
+		if e.Contract != r.ContractAddress {
+			protoEvent.Contract = e.Contract.Bytes()
+		}
+		protoObj.Events = append(protoObj.Events, protoEvent)
 	}
 	return protoObj
 }
@@ -1564,10 +1571,15 @@ func (r *TxReceipt) FromProto(protoObj *models.ProtoTxReceipts_ProtoTxReceipt) {
 
 	for idx := range protoObj.Events {
 		e := protoObj.Events[idx]
-		r.Events = append(r.Events, &TxEvent{
+		event := &TxEvent{
+			Contract:  contract,
 			EventName: e.Event,
 			Data:      e.Data,
-		})
+		}
+		if len(e.Contract) > 0 {
+			event.Contract = common.BytesToAddress(e.Contract)
+		}
+		r.Events = append(r.Events, event)
 	}
 }
 
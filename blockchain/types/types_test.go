@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/idena-network/idena-go/common"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
@@ -72,3 +73,21 @@ func TestBlockCert_Empty(t *testing.T) {
 	var cert *BlockCert
 	require.True(t, cert.Empty())
 }
+
+func TestTxReceipt_eventContract(t *testing.T) {
+	contract, callee := common.Address{0x1}, common.Address{0x2}
+	receipt := &TxReceipt{ContractAddress: contract, Events: []*TxEvent{
+		{Contract: contract, EventName: "a"},
+		{Contract: callee, EventName: "b"},
+	}}
+	protoObj := receipt.ToProto()
+	require.Nil(t, protoObj.Events[0].Contract)
+	require.Equal(t, callee.Bytes(), protoObj.Events[1].Contract)
+
+	data, err := receipt.ToBytes()
+	require.NoError(t, err)
+	restored := new(TxReceipt)
+	require.NoError(t, restored.FromBytes(data))
+	require.Equal(t, contract, restored.Events[0].Contract)
+	require.Equal(t, callee, restored.Events[1].Contract)
+}
@@ -718,6 +718,12 @@ func validateDeployContractTx(appState *appstate.AppState, tx *types.Transaction
 	if _, ok := embedded.AvailableContracts[attachment.CodeHash]; !ok {
 		return InvalidPayload
 	}
+	switch attachment.CodeHash {
+	case embedded.FungibleTokenContract, embedded.VestingContract, embedded.Multisig2Contract:
+		if appCfg == nil || !appCfg.Consensus.EnableUpgrade9 {
+			return InvalidPayload
+		}
+	}
 	return nil
 }
 
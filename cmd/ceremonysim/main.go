@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-go/core/ceremony/ceremonysim"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"os"
+)
+
+// ceremonysim runs a json scenario of a validation ceremony on an in-memory state and prints the flip assignment,
+// qualifications, new identity states and rewards, see ceremonysim.Scenario for the format.
+
+var (
+	summaryFlag = cli.BoolFlag{
+		Name:  "summary",
+		Usage: "Print results of identity groups only",
+	}
+	seedFlag = cli.Int64Flag{
+		Name:  "seed",
+		Usage: "Override the scenario seed",
+	}
+)
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "ceremonysim"
+	app.Usage = "Simulate a validation ceremony without a node"
+	app.ArgsUsage = "<scenario file>"
+	app.Flags = []cli.Flag{
+		summaryFlag,
+		seedFlag,
+	}
+
+	app.Action = func(ctx *cli.Context) error {
+		if ctx.NArg() != 1 {
+			return errors.New("scenario file is required")
+		}
+		log.Root().SetHandler(log.DiscardHandler())
+
+		scenario, err := ceremonysim.LoadScenario(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+		if ctx.IsSet(seedFlag.Name) {
+			scenario.Seed = ctx.Int64(seedFlag.Name)
+		}
+		res, err := scenario.Run()
+		if err != nil {
+			return err
+		}
+		if ctx.Bool(summaryFlag.Name) {
+			res.Flips, res.Identities = nil, nil
+		}
+		data, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,555 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/keystore"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// idena-tx builds and signs transactions without a node, so keys can be kept on an air-gapped machine.
+// The printed hex can be sent to any node with bcn_sendRawTx.
+
+var (
+	keyFileFlag = cli.StringFlag{
+		Name:  "keyfile",
+		Usage: "Keystore file of the signer",
+	}
+	passwordFlag = cli.StringFlag{
+		Name:  "password",
+		Usage: "Password of the keystore file",
+	}
+	passwordFileFlag = cli.StringFlag{
+		Name:  "passwordfile",
+		Usage: "File with the password of the keystore file",
+	}
+	nonceFlag = cli.UintFlag{
+		Name:  "nonce",
+		Usage: "Account nonce of the transaction",
+	}
+	epochFlag = cli.UintFlag{
+		Name:  "epoch",
+		Usage: "Epoch of the transaction",
+	}
+	maxFeeFlag = cli.StringFlag{
+		Name:  "maxfee",
+		Usage: "Max fee in iDNA",
+	}
+	tipsFlag = cli.StringFlag{
+		Name:  "tips",
+		Usage: "Tips in iDNA",
+	}
+	rlpFlag = cli.BoolFlag{
+		Name:  "rlp",
+		Usage: "Encode transaction with rlp instead of protobuf",
+	}
+
+	toFlag = cli.StringFlag{
+		Name:  "to",
+		Usage: "Recipient address",
+	}
+	contractFlag = cli.StringFlag{
+		Name:  "contract",
+		Usage: "Contract address",
+	}
+	amountFlag = cli.StringFlag{
+		Name:  "amount",
+		Usage: "Amount in iDNA",
+	}
+	payloadFlag = cli.StringFlag{
+		Name:  "payload",
+		Usage: "Hex encoded payload",
+	}
+	typeFlag = cli.UintFlag{
+		Name:  "type",
+		Usage: "Transaction type",
+	}
+	pubKeyFlag = cli.StringFlag{
+		Name:  "pubkey",
+		Usage: "Hex encoded public key of the activated address",
+	}
+	cidFlag = cli.StringFlag{
+		Name:  "cid",
+		Usage: "IPFS cid",
+	}
+	pairFlag = cli.UintFlag{
+		Name:  "pair",
+		Usage: "Flip words pair index",
+	}
+	sizeFlag = cli.UintFlag{
+		Name:  "size",
+		Usage: "Size of the data stored to IPFS",
+	}
+	burnKeyFlag = cli.StringFlag{
+		Name:  "key",
+		Usage: "Burn key",
+	}
+	codeHashFlag = cli.StringFlag{
+		Name:  "codehash",
+		Usage: "Hex encoded contract code hash",
+	}
+	methodFlag = cli.StringFlag{
+		Name:  "method",
+		Usage: "Contract method",
+	}
+	argsFlag = cli.StringSliceFlag{
+		Name:  "arg",
+		Usage: "Hex encoded contract argument, can be repeated",
+	}
+)
+
+type txParams struct {
+	to      *common.Address
+	amount  decimal.Decimal
+	payload []byte
+}
+
+type txCommand struct {
+	name   string
+	usage  string
+	txType types.TxType
+	flags  []cli.Flag
+	build  func(ctx *cli.Context) (*txParams, error)
+}
+
+var txCommands = []txCommand{
+	{
+		name:   "send",
+		usage:  "Send coins",
+		txType: types.SendTx,
+		flags:  []cli.Flag{toFlag, amountFlag, payloadFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, true, nil)
+		},
+	},
+	{
+		name:   "activate",
+		usage:  "Activate invite, the keystore file must contain the invitation key",
+		txType: types.ActivationTx,
+		flags:  []cli.Flag{toFlag, pubKeyFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			pubKey, err := hexutil.Decode(ctx.String(pubKeyFlag.Name))
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid public key")
+			}
+			return buildParams(ctx, toFlag, false, pubKey)
+		},
+	},
+	{
+		name:   "invite",
+		usage:  "Send invite",
+		txType: types.InviteTx,
+		flags:  []cli.Flag{toFlag, amountFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, true, nil)
+		},
+	},
+	{
+		name:   "kill",
+		usage:  "Kill own identity",
+		txType: types.KillTx,
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return &txParams{}, nil
+		},
+	},
+	{
+		name:   "killinvitee",
+		usage:  "Kill invitee identity",
+		txType: types.KillInviteeTx,
+		flags:  []cli.Flag{toFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, false, nil)
+		},
+	},
+	{
+		name:   "online",
+		usage:  "Become online",
+		txType: types.OnlineStatusTx,
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return &txParams{payload: attachments.CreateOnlineStatusAttachment(true)}, nil
+		},
+	},
+	{
+		name:   "offline",
+		usage:  "Become offline",
+		txType: types.OnlineStatusTx,
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return &txParams{payload: attachments.CreateOnlineStatusAttachment(false)}, nil
+		},
+	},
+	{
+		name:   "changegodaddress",
+		usage:  "Change god address",
+		txType: types.ChangeGodAddressTx,
+		flags:  []cli.Flag{toFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, false, nil)
+		},
+	},
+	{
+		name:   "burn",
+		usage:  "Burn coins",
+		txType: types.BurnTx,
+		flags:  []cli.Flag{amountFlag, burnKeyFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			amount, err := parseAmount(ctx, amountFlag)
+			if err != nil {
+				return nil, err
+			}
+			return &txParams{amount: amount, payload: attachments.CreateBurnAttachment(ctx.String(burnKeyFlag.Name))}, nil
+		},
+	},
+	{
+		name:   "changeprofile",
+		usage:  "Change profile",
+		txType: types.ChangeProfileTx,
+		flags:  []cli.Flag{cidFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			c, err := parseCid(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &txParams{payload: attachments.CreateChangeProfileAttachment(c)}, nil
+		},
+	},
+	{
+		name:   "submitflip",
+		usage:  "Submit flip",
+		txType: types.SubmitFlipTx,
+		flags:  []cli.Flag{cidFlag, pairFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			c, err := parseCid(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &txParams{payload: attachments.CreateFlipSubmitAttachment(c, uint8(ctx.Uint(pairFlag.Name)))}, nil
+		},
+	},
+	{
+		name:   "deleteflip",
+		usage:  "Delete flip",
+		txType: types.DeleteFlipTx,
+		flags:  []cli.Flag{cidFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			c, err := parseCid(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &txParams{payload: attachments.CreateDeleteFlipAttachment(c)}, nil
+		},
+	},
+	{
+		name:   "deploy",
+		usage:  "Deploy contract",
+		txType: types.DeployContractTx,
+		flags:  []cli.Flag{codeHashFlag, amountFlag, argsFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			codeHash, err := hexutil.Decode(ctx.String(codeHashFlag.Name))
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid code hash")
+			}
+			args, err := parseArgs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			payload, err := attachments.CreateDeployContractAttachment(common.BytesToHash(codeHash), args...).ToBytes()
+			if err != nil {
+				return nil, err
+			}
+			amount, err := parseAmount(ctx, amountFlag)
+			if err != nil {
+				return nil, err
+			}
+			return &txParams{amount: amount, payload: payload}, nil
+		},
+	},
+	{
+		name:   "call",
+		usage:  "Call contract method",
+		txType: types.CallContractTx,
+		flags:  []cli.Flag{contractFlag, methodFlag, amountFlag, argsFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			args, err := parseArgs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			payload, err := attachments.CreateCallContractAttachment(ctx.String(methodFlag.Name), args...).ToBytes()
+			if err != nil {
+				return nil, err
+			}
+			return buildParams(ctx, contractFlag, true, payload)
+		},
+	},
+	{
+		name:   "terminate",
+		usage:  "Terminate contract",
+		txType: types.TerminateContractTx,
+		flags:  []cli.Flag{contractFlag, argsFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			args, err := parseArgs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			payload, err := attachments.CreateTerminateContractAttachment(args...).ToBytes()
+			if err != nil {
+				return nil, err
+			}
+			return buildParams(ctx, contractFlag, false, payload)
+		},
+	},
+	{
+		name:   "delegate",
+		usage:  "Delegate mining rewards to pool",
+		txType: types.DelegateTx,
+		flags:  []cli.Flag{toFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, false, nil)
+		},
+	},
+	{
+		name:   "undelegate",
+		usage:  "Undelegate mining rewards",
+		txType: types.UndelegateTx,
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return &txParams{}, nil
+		},
+	},
+	{
+		name:   "killdelegator",
+		usage:  "Kill pool delegator",
+		txType: types.KillDelegatorTx,
+		flags:  []cli.Flag{toFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, false, nil)
+		},
+	},
+	{
+		name:   "storetoipfs",
+		usage:  "Pay for storing data to IPFS",
+		txType: types.StoreToIpfsTx,
+		flags:  []cli.Flag{cidFlag, sizeFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			c, err := parseCid(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return &txParams{payload: attachments.CreateStoreToIpfsAttachment(c, uint32(ctx.Uint(sizeFlag.Name)))}, nil
+		},
+	},
+	{
+		name:   "replenishstake",
+		usage:  "Replenish identity stake",
+		txType: types.ReplenishStakeTx,
+		flags:  []cli.Flag{toFlag, amountFlag},
+		build: func(ctx *cli.Context) (*txParams, error) {
+			return buildParams(ctx, toFlag, true, nil)
+		},
+	},
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "idena-tx"
+	app.Usage = "Build and sign transactions offline, the output can be sent with bcn_sendRawTx"
+
+	app.Flags = []cli.Flag{
+		keyFileFlag,
+		passwordFlag,
+		passwordFileFlag,
+		nonceFlag,
+		epochFlag,
+		maxFeeFlag,
+		tipsFlag,
+		rlpFlag,
+	}
+
+	for idx := range txCommands {
+		command := txCommands[idx]
+		app.Commands = append(app.Commands, cli.Command{
+			Name:  command.name,
+			Usage: command.usage,
+			Flags: command.flags,
+			Action: func(ctx *cli.Context) error {
+				params, err := command.build(ctx)
+				if err != nil {
+					return err
+				}
+				return signAndPrint(ctx, command.txType, params)
+			},
+		})
+	}
+	app.Commands = append(app.Commands, cli.Command{
+		Name:  "raw",
+		Usage: "Build transaction of any type with the given payload",
+		Flags: []cli.Flag{typeFlag, toFlag, amountFlag, payloadFlag},
+		Action: func(ctx *cli.Context) error {
+			if !ctx.IsSet(typeFlag.Name) {
+				return errors.New("type is required")
+			}
+			params, err := buildParams(ctx, toFlag, true, nil)
+			if err != nil {
+				return err
+			}
+			return signAndPrint(ctx, types.TxType(ctx.Uint(typeFlag.Name)), params)
+		},
+	})
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+// buildParams parses optional recipient, amount and payload flags, the payload flag is used if the payload is not given
+func buildParams(ctx *cli.Context, addressFlag cli.StringFlag, withAmount bool, payload []byte) (*txParams, error) {
+	params := &txParams{payload: payload}
+	if ctx.IsSet(addressFlag.Name) {
+		addr, err := parseAddress(ctx.String(addressFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		params.to = &addr
+	}
+	if withAmount {
+		amount, err := parseAmount(ctx, amountFlag)
+		if err != nil {
+			return nil, err
+		}
+		params.amount = amount
+	}
+	if payload == nil && ctx.IsSet(payloadFlag.Name) {
+		data, err := hexutil.Decode(ctx.String(payloadFlag.Name))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid payload")
+		}
+		params.payload = data
+	}
+	return params, nil
+}
+
+func signAndPrint(ctx *cli.Context, txType types.TxType, params *txParams) error {
+	if !ctx.GlobalIsSet(nonceFlag.Name) || !ctx.GlobalIsSet(epochFlag.Name) {
+		return errors.New("nonce and epoch are required since there is no node to fill them")
+	}
+	if !ctx.GlobalIsSet(maxFeeFlag.Name) {
+		return errors.New("max fee is required since there is no node to estimate it")
+	}
+	maxFee, err := decimal.NewFromString(ctx.GlobalString(maxFeeFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "invalid max fee")
+	}
+	var tips decimal.Decimal
+	if ctx.GlobalIsSet(tipsFlag.Name) {
+		if tips, err = decimal.NewFromString(ctx.GlobalString(tipsFlag.Name)); err != nil {
+			return errors.Wrap(err, "invalid tips")
+		}
+	}
+	key, err := loadKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx := &types.Transaction{
+		AccountNonce: uint32(ctx.GlobalUint(nonceFlag.Name)),
+		Epoch:        uint16(ctx.GlobalUint(epochFlag.Name)),
+		Type:         txType,
+		To:           params.to,
+		Amount:       blockchain.ConvertToInt(params.amount),
+		MaxFee:       blockchain.ConvertToInt(maxFee),
+		Tips:         blockchain.ConvertToInt(tips),
+		Payload:      params.payload,
+	}
+	data, err := signTx(tx, key, ctx.GlobalBool(rlpFlag.Name))
+	if err != nil {
+		return err
+	}
+	fmt.Println(hexutil.Encode(data))
+	return nil
+}
+
+// signTx signs and encodes the transaction, rlp encoded transactions are signed over the rlp hash
+// since nodes recover their senders with it
+func signTx(tx *types.Transaction, key *ecdsa.PrivateKey, useRlp bool) ([]byte, error) {
+	tx.UseRlp = useRlp
+	signedTx, err := types.SignTx(tx, key)
+	if err != nil {
+		return nil, err
+	}
+	if useRlp {
+		return rlp.EncodeToBytes(signedTx)
+	}
+	return signedTx.ToBytes()
+}
+
+func loadKey(ctx *cli.Context) (*ecdsa.PrivateKey, error) {
+	if !ctx.GlobalIsSet(keyFileFlag.Name) {
+		return nil, errors.New("keystore file is required")
+	}
+	keyJson, err := ioutil.ReadFile(ctx.GlobalString(keyFileFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	password := ctx.GlobalString(passwordFlag.Name)
+	if ctx.GlobalIsSet(passwordFileFlag.Name) {
+		data, err := ioutil.ReadFile(ctx.GlobalString(passwordFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		password = strings.TrimRight(string(data), "\r\n")
+	}
+	key, err := keystore.DecryptKey(keyJson, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt keystore file")
+	}
+	return key.PrivateKey, nil
+}
+
+func parseAddress(value string) (common.Address, error) {
+	if !common.IsHexAddress(value) {
+		return common.Address{}, errors.Errorf("invalid address %v", value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+func parseAmount(ctx *cli.Context, flag cli.StringFlag) (decimal.Decimal, error) {
+	if !ctx.IsSet(flag.Name) {
+		return decimal.Decimal{}, nil
+	}
+	amount, err := decimal.NewFromString(ctx.String(flag.Name))
+	if err != nil {
+		return decimal.Decimal{}, errors.Wrapf(err, "invalid %v", flag.Name)
+	}
+	return amount, nil
+}
+
+func parseCid(ctx *cli.Context) ([]byte, error) {
+	c, err := cid.Decode(ctx.String(cidFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cid")
+	}
+	return c.Bytes(), nil
+}
+
+func parseArgs(ctx *cli.Context) ([][]byte, error) {
+	var args [][]byte
+	for _, value := range ctx.StringSlice(argsFlag.Name) {
+		arg, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid argument %v", value)
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func Test_signTx(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.Address{0x1}
+
+	for _, useRlp := range []bool{false, true} {
+		tx := &types.Transaction{
+			AccountNonce: 3,
+			Epoch:        7,
+			Type:         types.SendTx,
+			To:           &to,
+			Amount:       big.NewInt(100),
+			MaxFee:       big.NewInt(10),
+			Tips:         big.NewInt(1),
+			Payload:      []byte{0x1, 0x2},
+		}
+		data, err := signTx(tx, key, useRlp)
+		require.NoError(t, err)
+
+		decoded := new(types.Transaction)
+		if useRlp {
+			require.NoError(t, rlp.DecodeBytes(data, decoded))
+			decoded.UseRlp = true
+		} else {
+			require.NoError(t, decoded.FromBytes(data))
+		}
+		sender, err := types.Sender(decoded)
+		require.NoError(t, err)
+		require.Equal(t, addr, sender)
+		require.Equal(t, tx.AccountNonce, decoded.AccountNonce)
+		require.Equal(t, tx.Epoch, decoded.Epoch)
+		require.Equal(t, tx.Amount, decoded.Amount)
+		require.Equal(t, tx.Payload, decoded.Payload)
+		require.Equal(t, to, *decoded.To)
+	}
+}
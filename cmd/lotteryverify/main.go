@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-go/api"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+// lotteryverify recomputes flips assigned to an identity by the flip lottery from the seed, the candidate list and
+// flip cids of the identity shard returned by flip_lotteryProof, so the distribution can be audited without trusting
+// the node. The seed is checked against the seed block header and identities against their state proofs at the lottery
+// block, headers are checked against block hashes of the node given by --hashesurl. The proof is read from a file
+// (the result of flip_lotteryProof or the whole rpc response) or requested from a node.
+
+var (
+	urlFlag = cli.StringFlag{
+		Name:  "url",
+		Usage: "Rpc url of the node to request the proof from",
+		Value: "http://localhost:9009",
+	}
+	apiKeyFlag = cli.StringFlag{
+		Name:  "apikey",
+		Usage: "Rpc api key of the node",
+	}
+	addressFlag = cli.StringFlag{
+		Name:  "address",
+		Usage: "Identity address to request the proof for",
+	}
+	epochFlag = cli.UintFlag{
+		Name:  "epoch",
+		Usage: "Epoch of the lottery, the current epoch by default",
+	}
+	hashesUrlFlag = cli.StringFlag{
+		Name:  "hashesurl",
+		Usage: "Rpc url of a trusted node to check lottery block hashes against, the proof node by default",
+	}
+	skipInputsFlag = cli.BoolFlag{
+		Name:  "skipinputs",
+		Usage: "Don't check the seed and identities against the chain, e.g. if the state of the lottery block is pruned",
+	}
+)
+
+type rpcRequest struct {
+	Key     string        `json:"key,omitempty"`
+	Method  string        `json:"method"`
+	JsonRpc string        `json:"jsonrpc"`
+	Id      int           `json:"id"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "lotteryverify"
+	app.Usage = "Verify flips assigned to an identity by the flip lottery"
+	app.ArgsUsage = "[proof file]"
+	app.Flags = []cli.Flag{
+		urlFlag,
+		apiKeyFlag,
+		addressFlag,
+		epochFlag,
+		hashesUrlFlag,
+		skipInputsFlag,
+	}
+
+	app.Action = func(ctx *cli.Context) error {
+		var proof *api.LotteryProof
+		var err error
+		if ctx.NArg() > 0 {
+			proof, err = readProof(ctx.Args().First())
+		} else {
+			proof, err = requestProof(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		ceremonyProof, err := proof.CeremonyProof()
+		if err != nil {
+			return err
+		}
+		if err := ceremony.VerifyLotteryProof(ceremonyProof); err != nil {
+			return errors.Wrap(err, "flip distribution does not match the lottery")
+		}
+		if ctx.Bool(skipInputsFlag.Name) {
+			fmt.Println("seed and identities are not checked against the chain")
+		} else {
+			if err := verifyInputs(ctx, ceremonyProof); err != nil {
+				return errors.Wrap(err, "lottery inputs do not match the chain")
+			}
+		}
+		fmt.Printf("flip distribution of %v in epoch %v matches the lottery: %v short and %v long session flips, %v identities in shard %v\n",
+			proof.Address.Hex(), proof.Epoch, len(proof.ShortFlips), len(proof.LongFlips), len(proof.Identities), proof.ShardId)
+		return nil
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+func readProof(path string) (*api.LotteryProof, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	response := new(rpcResponse)
+	if err := json.Unmarshal(data, response); err == nil && len(response.Result) > 0 {
+		data = response.Result
+	}
+	proof := new(api.LotteryProof)
+	if err := json.Unmarshal(data, proof); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the proof")
+	}
+	return proof, nil
+}
+
+func requestProof(ctx *cli.Context) (*api.LotteryProof, error) {
+	if !ctx.IsSet(addressFlag.Name) {
+		return nil, errors.New("proof file or address is required")
+	}
+	address := common.HexToAddress(ctx.String(addressFlag.Name))
+	params := []interface{}{address}
+	if ctx.IsSet(epochFlag.Name) {
+		params = append(params, ctx.Uint(epochFlag.Name))
+	}
+	proof := new(api.LotteryProof)
+	if err := call(ctx.String(urlFlag.Name), ctx.String(apiKeyFlag.Name), "flip_lotteryProof", params, proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// verifyInputs checks headers of the lottery blocks against hashes of the trusted node and inputs of the proof against the headers
+func verifyInputs(ctx *cli.Context, proof *ceremony.LotteryProof) error {
+	if proof.Header == nil || proof.SeedHeader == nil {
+		return errors.New("headers of the lottery blocks are missing")
+	}
+	url, apiKey := ctx.String(hashesUrlFlag.Name), ""
+	if url == "" {
+		url, apiKey = ctx.String(urlFlag.Name), ctx.String(apiKeyFlag.Name)
+	}
+	for _, header := range []*types.Header{proof.Header, proof.SeedHeader} {
+		block := new(api.Block)
+		if err := call(url, apiKey, "bcn_blockAt", []interface{}{header.Height()}, block); err != nil {
+			return errors.Wrapf(err, "failed to get block %v", header.Height())
+		}
+		if block.Hash != header.Hash() {
+			return errors.Errorf("header of block %v doesn't match the block hash %v", header.Height(), block.Hash.Hex())
+		}
+	}
+	return ceremony.VerifyLotteryInputs(proof)
+}
+
+func call(url string, apiKey string, method string, params []interface{}, result interface{}) error {
+	body, _ := json.Marshal(rpcRequest{
+		Key:     apiKey,
+		Method:  method,
+		JsonRpc: "2.0",
+		Id:      1,
+		Params:  params,
+	})
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	response := new(rpcResponse)
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return errors.Wrap(err, "failed to parse the rpc response")
+	}
+	if response.Error != nil {
+		return errors.New(response.Error.Message)
+	}
+	if len(response.Result) == 0 || string(response.Result) == "null" {
+		return errors.New("empty rpc response")
+	}
+	return json.Unmarshal(response.Result, result)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/vm/vmtest/scenario"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"os"
+	"path/filepath"
+)
+
+// vmtest runs json scenarios of embedded contracts on an in-memory state, see scenario.Scenario for the format.
+// Directories are expanded to *.json files they contain.
+
+func scenarioFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(arg, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "vmtest"
+	app.Usage = "Run embedded contract scenarios without a node"
+	app.ArgsUsage = "<scenario file or directory>..."
+
+	app.Action = func(ctx *cli.Context) error {
+		if ctx.NArg() == 0 {
+			return errors.New("scenario files are required")
+		}
+		files, err := scenarioFiles(ctx.Args())
+		if err != nil {
+			return err
+		}
+		failed := 0
+		for _, file := range files {
+			s, err := scenario.Load(file)
+			if err == nil {
+				err = s.Run()
+			}
+			if err != nil {
+				failed++
+				fmt.Printf("FAIL %v: %v\n", file, err)
+				continue
+			}
+			fmt.Printf("ok   %v\n", s.Name)
+		}
+		if failed > 0 {
+			return errors.Errorf("%v of %v scenarios failed", failed, len(files))
+		}
+		return nil
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
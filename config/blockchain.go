@@ -4,4 +4,6 @@ type BlockchainConfig struct {
 	// distance between blocks with permanent certificates
 	StoreCertRange uint64
 	BurnTxRange    uint64
+	// IndexAllTxs enables indexing of transactions of all addresses instead of the node own accounts only
+	IndexAllTxs bool
 }
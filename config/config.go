@@ -40,6 +40,7 @@ type Config struct {
 	OfflineDetection *OfflineDetectionConfig
 	Blockchain       *BlockchainConfig
 	Mempool          *Mempool
+	Stats            *StatsConfig
 }
 
 func (c *Config) ProvideNodeKey(key string, password string, withBackup bool) error {
@@ -235,6 +236,9 @@ func getDefaultConfig(dataDir string) *Config {
 	ipfsConfig.BootNodes = DefaultIpfsBootstrapNodes
 	ipfsConfig.SwarmKey = DefaultSwarmKey
 
+	rpcConfig := rpc.GetDefaultRPCConfig(DefaultRpcHost, DefaultRpcPort)
+	rpcConfig.WSPort = DefaultWsPort
+
 	return &Config{
 		DataDir: dataDir,
 		Network: 0x1, // testnet
@@ -246,7 +250,7 @@ func getDefaultConfig(dataDir string) *Config {
 			DisableMetrics:           false,
 		},
 		Consensus: GetDefaultConsensusConfig(),
-		RPC:       rpc.GetDefaultRPCConfig(DefaultRpcHost, DefaultRpcPort),
+		RPC:       rpcConfig,
 		GenesisConf: &GenesisConf{
 			FirstCeremonyTime: DefaultCeremonyTime,
 			GodAddress:        common.HexToAddress(DefaultGodAddress),
@@ -264,6 +268,7 @@ func getDefaultConfig(dataDir string) *Config {
 			BurnTxRange:    DefaultBurntTxRange,
 		},
 		Mempool: GetDefaultMempoolConfig(),
+		Stats:   &StatsConfig{},
 	}
 }
 
@@ -277,6 +282,8 @@ func applyFlags(ctx *cli.Context, cfg *Config) {
 	applyIpfsFlags(ctx, cfg)
 	applyValidationFlags(ctx, cfg)
 	applySyncFlags(ctx, cfg)
+	applyBlockchainFlags(ctx, cfg)
+	applyStatsFlags(ctx, cfg)
 }
 
 func applyCommonFlags(ctx *cli.Context, cfg *Config) {
@@ -292,6 +299,35 @@ func applySyncFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(ForceFullSyncFlag.Name) {
 		cfg.Sync.ForceFullSync = ctx.Uint64(ForceFullSyncFlag.Name)
 	}
+	if ctx.IsSet(LightModeFlag.Name) {
+		cfg.Sync.LightMode = ctx.Bool(LightModeFlag.Name)
+	}
+}
+
+func applyBlockchainFlags(ctx *cli.Context, cfg *Config) {
+	if ctx.IsSet(IndexAllTxsFlag.Name) {
+		cfg.Blockchain.IndexAllTxs = ctx.Bool(IndexAllTxsFlag.Name)
+	}
+}
+
+func applyStatsFlags(ctx *cli.Context, cfg *Config) {
+	if ctx.IsSet(StatsFlag.Name) {
+		cfg.Stats.Enabled = ctx.Bool(StatsFlag.Name)
+	}
+	enableStatsModule(cfg)
+}
+
+// enableStatsModule exposes the stats rpc namespace over http if the stats collector is enabled
+func enableStatsModule(cfg *Config) {
+	if !cfg.Stats.Enabled {
+		return
+	}
+	for _, module := range cfg.RPC.HTTPModules {
+		if module == "stats" {
+			return
+		}
+	}
+	cfg.RPC.HTTPModules = append(cfg.RPC.HTTPModules, "stats")
 }
 
 func applyP2PFlags(ctx *cli.Context, cfg *Config) {
@@ -313,6 +349,12 @@ func applyRpcFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(RpcPortFlag.Name) {
 		cfg.RPC.HTTPPort = ctx.Int(RpcPortFlag.Name)
 	}
+	if ctx.IsSet(WsHostFlag.Name) {
+		cfg.RPC.WSHost = ctx.String(WsHostFlag.Name)
+	}
+	if ctx.IsSet(WsPortFlag.Name) {
+		cfg.RPC.WSPort = ctx.Int(WsPortFlag.Name)
+	}
 	if ctx.IsSet(ApiKeyFlag.Name) {
 		cfg.RPC.APIKey = ctx.String(ApiKeyFlag.Name)
 	}
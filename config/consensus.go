@@ -53,6 +53,7 @@ type ConsensusConf struct {
 	NewKeyWordsEpoch                  uint16
 	EnableUpgrade7                    bool
 	EnableUpgrade8                    bool
+	EnableUpgrade9                    bool
 }
 
 type ConsensusVerson uint16
@@ -64,6 +65,9 @@ const (
 	ConsensusV7 ConsensusVerson = 7
 
 	ConsensusV8 ConsensusVerson = 8
+
+	// Enables fungible token, vesting and multisig v2 contracts, the version isn't scheduled yet so upgrade 9 is enabled by tests and tools only
+	ConsensusV9 ConsensusVerson = 9
 )
 
 var (
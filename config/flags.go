@@ -8,6 +8,7 @@ const (
 	DefaultRpcHost            = "localhost"
 	DefaultRpcPort            = 9009
 	DefaultRpcPortBuiltInNode = 9119
+	DefaultWsPort             = 9010
 	DefaultIpfsDataDir        = "ipfs"
 	DefaultIpfsPort           = 40405
 	DefaultGodAddress         = "0x4d60dc6a2cba8c3ef1ba5e1eba5c12c54cee6b61"
@@ -61,6 +62,14 @@ var (
 		Name:  "rpcport",
 		Usage: "RPC listening port",
 	}
+	WsHostFlag = cli.StringFlag{
+		Name:  "wsaddr",
+		Usage: "WebSocket RPC listening address (disabled if empty)",
+	}
+	WsPortFlag = cli.IntFlag{
+		Name:  "wsport",
+		Usage: "WebSocket RPC listening port",
+	}
 	BootNodeFlag = cli.StringFlag{
 		Name:  "bootnode",
 		Usage: "Bootstrap node url",
@@ -102,6 +111,22 @@ var (
 		Name:  "fast",
 		Usage: "Enable fast sync",
 	}
+	LightModeFlag = cli.BoolFlag{
+		Name:  "light",
+		Usage: "Run node in light mode following block headers and certificates only",
+	}
+	IndexAllTxsFlag = cli.BoolFlag{
+		Name:  "indexalltxs",
+		Usage: "Index transactions of all addresses",
+	}
+	StatsFlag = cli.BoolFlag{
+		Name:  "stats",
+		Usage: "Collect block and epoch stats available via stats rpc methods",
+	}
+	ReindexTxsFlag = cli.BoolFlag{
+		Name:  "reindextxs",
+		Usage: "Rebuild transaction index from stored blocks and exit",
+	}
 	ForceFullSyncFlag = cli.Uint64Flag{
 		Name:  "forcefullsync",
 		Usage: "Force full sync on last blocks",
@@ -10,6 +10,8 @@ type Mempool struct {
 	TxPoolAddrExecutableLimit int
 	TxLifetime                time.Duration
 	ResetInCeremony           bool
+	// TxReplacementBump is the percentage by which MaxFee+Tips of a tx should exceed the pending tx with the same nonce to replace it
+	TxReplacementBump int
 }
 
 func GetDefaultMempoolConfig() *Mempool {
@@ -20,5 +22,6 @@ func GetDefaultMempoolConfig() *Mempool {
 		TxPoolAddrQueueLimit:      32,
 		TxPoolAddrExecutableLimit: 32,
 		TxLifetime:                time.Hour * 3,
+		TxReplacementBump:         10,
 	}
 }
@@ -0,0 +1,6 @@
+package config
+
+type StatsConfig struct {
+	// Enabled turns on the built-in collector saving block and epoch stats to the node database
+	Enabled bool
+}
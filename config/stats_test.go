@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEnableStatsModule(t *testing.T) {
+	cfg := getDefaultConfig(DefaultDataDir)
+	enableStatsModule(cfg)
+	require.NotContains(t, cfg.RPC.HTTPModules, "stats")
+
+	cfg.Stats.Enabled = true
+	enableStatsModule(cfg)
+	enableStatsModule(cfg)
+	require.Equal(t, []string{"net", "dna", "account", "flip", "bcn", "ipfs", "contract", "stats"}, cfg.RPC.HTTPModules)
+}
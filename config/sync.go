@@ -7,4 +7,6 @@ type SyncConfig struct {
 	ForceFullSync       uint64
 	LoadAllFlips        bool
 	AllFlipsLoadingTime time.Duration
+	// LightMode makes the node follow block headers and certificates only without keeping the state
+	LightMode bool
 }
@@ -92,6 +92,11 @@ func NewEngine(chain *blockchain.Blockchain, gossipHandler *protocol.IdenaGossip
 func (engine *Engine) Start() {
 	engine.pubKey = engine.secStore.GetPubKey()
 	engine.addr = engine.secStore.GetAddress()
+	if engine.cfg.Sync.LightMode {
+		log.Info("Start light mode")
+		go engine.lightLoop()
+		return
+	}
 	log.Info("Start consensus protocol", "pubKey", hexutil.Encode(engine.pubKey))
 	engine.forkResolver.Start()
 	go engine.loop()
@@ -106,10 +111,33 @@ func (engine *Engine) ReadonlyAppState() (*appstate.AppState, error) {
 	return engine.appState.Readonly(engine.chain.Head.Height())
 }
 
+func (engine *Engine) ReadonlyAppStateAt(height uint64) (*appstate.AppState, error) {
+	if height > engine.chain.Head.Height() {
+		return nil, errors.Errorf("block %v is not found", height)
+	}
+	if height == engine.chain.Head.Height() {
+		return engine.ReadonlyAppState()
+	}
+	return engine.appState.ReadonlyHistorical(height)
+}
+
 func (engine *Engine) AppStateForCheck() (*appstate.AppState, error) {
 	return engine.appState.ForCheck(engine.chain.Head.Height())
 }
 
+// lightLoop follows block headers and certificates without taking part in consensus
+func (engine *Engine) lightLoop() {
+	for {
+		if err := engine.downloader.SyncBlockchain(engine.forkResolver); err != nil {
+			engine.synced = false
+			engine.log.Warn("syncing error", "err", err)
+		} else {
+			engine.synced = engine.pm.HasPeers()
+		}
+		time.Sleep(time.Second * 5)
+	}
+}
+
 func (engine *Engine) alignTime() {
 	if engine.prevRoundDuration > engine.cfg.Consensus.MinBlockDistance {
 		return
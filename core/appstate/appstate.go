@@ -80,6 +80,25 @@ func (s *AppState) Readonly(height uint64) (*AppState, error) {
 		return state, nil
 	}
 
+	state, err := s.readonly(height)
+	if err != nil {
+		return nil, err
+	}
+
+	s.readonlyStateCache = map[uint64]*AppState{height: state}
+	return state, nil
+}
+
+// ReadonlyHistorical loads readonly app state of the given height bypassing the readonly state cache,
+// so queries of old heights don't evict the cached head state
+func (s *AppState) ReadonlyHistorical(height uint64) (*AppState, error) {
+	if !s.State.HasVersion(height) || !s.IdentityState.HasVersion(height) {
+		return nil, errors.Errorf("state of height %v is not available, it may have been pruned", height)
+	}
+	return s.readonly(height)
+}
+
+func (s *AppState) readonly(height uint64) (*AppState, error) {
 	st, err := s.State.Readonly(int64(height))
 	if err != nil {
 		return nil, err
@@ -94,15 +113,12 @@ func (s *AppState) Readonly(height uint64) (*AppState, error) {
 		validatorsCache = validators.NewValidatorsCache(identityState, st.GodAddress())
 		validatorsCache.Load()
 	}
-	state := &AppState{
+	return &AppState{
 		State:           st,
 		IdentityState:   identityState,
 		ValidatorsCache: validatorsCache,
 		NonceCache:      s.NonceCache,
-	}
-
-	s.readonlyStateCache = map[uint64]*AppState{height: state}
-	return state, nil
+	}, nil
 }
 
 // loads appState
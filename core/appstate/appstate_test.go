@@ -75,3 +75,31 @@ func TestAppState_migrateToUpgrade8(t *testing.T) {
 	require.True(t, appState.ValidatorsCache.IsDiscriminated(common.Address{0x1}))
 	require.Equal(t, common.Address{0x2}, *appState.IdentityState.Delegatee(common.Address{0x1}))
 }
+
+func TestAppState_ReadonlyHistorical(t *testing.T) {
+	db := db2.NewMemDB()
+	bus := eventbus.New()
+	appState, _ := NewAppState(db, bus)
+
+	addr := common.Address{0x1}
+
+	appState.State.SetNonce(addr, 1)
+	appState.IdentityState.SetValidated(addr, true)
+	require.NoError(t, appState.Commit(nil, true))
+
+	appState.State.SetNonce(addr, 2)
+	require.NoError(t, appState.Commit(nil, true))
+
+	appState.Initialize(2)
+
+	historical, err := appState.ReadonlyHistorical(1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), historical.State.GetNonce(addr))
+
+	head, err := appState.Readonly(2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), head.State.GetNonce(addr))
+
+	_, err = appState.ReadonlyHistorical(3)
+	require.Error(t, err)
+}
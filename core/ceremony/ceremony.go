@@ -394,9 +394,11 @@ func (vc *ValidationCeremony) startValidationShortSessionTimer() {
 func (vc *ValidationCeremony) completeEpoch() {
 	if vc.epoch != vc.appState.State.Epoch() {
 		edb := vc.epochDb
+		epoch := vc.epoch
 		go func() {
 			vc.dropFlips(edb)
 			edb.Clear()
+			vc.dropLotteries(epoch)
 		}()
 	}
 	vc.epochDb = database.NewEpochDb(vc.db, vc.appState.State.Epoch())
@@ -426,6 +428,26 @@ func (vc *ValidationCeremony) completeEpoch() {
 	vc.allFlipsIsLoading = false
 }
 
+// LotteryProofEpochs is the number of last epochs which flip lottery inputs are kept for to prove flip distributions
+const LotteryProofEpochs = 10
+
+// dropLotteries removes lottery inputs of epochs which are LotteryProofEpochs or more epochs older than the finished one
+func (vc *ValidationCeremony) dropLotteries(finishedEpoch uint16) {
+	if finishedEpoch < LotteryProofEpochs {
+		return
+	}
+	for epoch := finishedEpoch - LotteryProofEpochs; ; epoch-- {
+		edb := database.NewEpochDb(vc.db, epoch)
+		if edb.ReadLotterySeed() == nil {
+			return
+		}
+		edb.ClearLottery()
+		if epoch == 0 {
+			return
+		}
+	}
+}
+
 func (vc *ValidationCeremony) handleBlock(block *types.Block) {
 	vc.blockHandlers[vc.appState.State.ValidationPeriod()](block)
 }
@@ -443,6 +465,7 @@ func (vc *ValidationCeremony) handleFlipLotteryPeriod(block *types.Block) {
 		seedBlock := vc.chain.GetBlockHeaderByHeight(seedHeight)
 
 		vc.epochDb.WriteLotterySeed(seedBlock.Seed().Bytes())
+		vc.epochDb.WriteLotteryHeights(block.Height(), seedHeight)
 
 		go vc.asyncFlipLotteryCalculations()
 	}
@@ -555,15 +578,7 @@ func (vc *ValidationCeremony) calculateCeremonyCandidates(restore bool) {
 	}
 	vc.candidateIndexes = m
 
-	shortFlipsCount := int(common.ShortSessionFlipsCount() + common.ShortSessionExtraFlipsCount())
-	vc.shardLotteries = GetAuthorsDistribution(vc.shardCandidates, seed, shortFlipsCount)
-
-	for shardId := range vc.shardCandidates {
-		shard := vc.shardCandidates[shardId]
-		shard.shortFlipsPerCandidate, shard.longFlipsPerCandidate = GetFlipsDistribution(len(shard.candidates), vc.shardLotteries[shardId].authorsPerCandidate, shard.flipsPerAuthor, shard.flips, seed, shortFlipsCount)
-	}
-
-	vc.lottery.finished = true
+	vc.distributeFlips(seed)
 
 	coinbase := vc.secStore.GetAddress()
 	coinbaseIdentity := vc.appState.State.GetIdentity(coinbase)
@@ -591,6 +606,18 @@ func (vc *ValidationCeremony) calculateCeremonyCandidates(restore bool) {
 	vc.logInfoWithInteraction("Should solve flips in long session", "cnt", len(longToSolve))
 }
 
+func (vc *ValidationCeremony) distributeFlips(seed []byte) {
+	shortFlipsCount := int(common.ShortSessionFlipsCount() + common.ShortSessionExtraFlipsCount())
+	vc.shardLotteries = GetAuthorsDistribution(vc.shardCandidates, seed, shortFlipsCount)
+
+	for shardId := range vc.shardCandidates {
+		shard := vc.shardCandidates[shardId]
+		shard.shortFlipsPerCandidate, shard.longFlipsPerCandidate = GetFlipsDistribution(len(shard.candidates), vc.shardLotteries[shardId].authorsPerCandidate, shard.flipsPerAuthor, shard.flips, seed, shortFlipsCount)
+	}
+
+	vc.lottery.finished = true
+}
+
 func (vc *ValidationCeremony) shouldInteractWithNetwork() bool {
 
 	if !vc.syncer.IsSyncing() {
@@ -688,58 +715,11 @@ func (vc *ValidationCeremony) getCandidatesAndFlips(restore bool) map[common.Sha
 	candidatesDistibution := make(map[common.ShardId]*candidatesOfShard)
 	shardsNum := vc.appState.State.ShardsNum()
 	for i := uint32(1); i <= shardsNum; i++ {
-		candidatesDistibution[common.ShardId(i)] = &candidatesOfShard{
-			candidates:        make([]*candidate, 0),
-			flips:             make([][]byte, 0),
-			flipsPerAuthor:    make(map[int][][]byte),
-			flipAuthorMap:     make(map[string]common.Address),
-			longFlipsToSolve:  map[common.Address][][]byte{},
-			shortFlipsToSolve: map[common.Address][][]byte{},
-			nonCandidates:     make([]common.Address, 0),
-		}
-	}
-
-	addFlips := func(author common.Address, shardId common.ShardId, identityFlipCids [][]byte) {
-		shard := candidatesDistibution[shardId]
-		authorIndex := len(shard.candidates)
-		for _, flipCid := range identityFlipCids {
-			shard.flips = append(shard.flips, flipCid)
-			shard.flipsPerAuthor[authorIndex] = append(shard.flipsPerAuthor[authorIndex], flipCid)
-			shard.flipAuthorMap[string(flipCid)] = author
-		}
-	}
-
-	toDbLotteryIdentity := func(addr common.Address, data state.Identity) database.DbLotteryIdentity {
-		res := database.DbLotteryIdentity{
-			Address:                 addr,
-			ShiftedShardId:          data.ShiftedShardId(),
-			PubKey:                  data.PubKey,
-			State:                   uint8(data.State),
-			HasDoneAllRequiredFlips: data.HasDoneAllRequiredFlips(),
-		}
-		if len(data.Flips) > 0 {
-			res.FlipCids = make([][]byte, 0, len(data.Flips))
-			for _, identityFlip := range data.Flips {
-				res.FlipCids = append(res.FlipCids, identityFlip.Cid)
-			}
-		}
-		return res
+		candidatesDistibution[common.ShardId(i)] = newCandidatesOfShard()
 	}
 
 	handleIdentity := func(identity database.DbLotteryIdentity) {
-		shard := candidatesDistibution[identity.ShiftedShardId]
-		addr := identity.Address
-		if state.IsCeremonyCandidateData(state.IdentityState(identity.State), identity.HasDoneAllRequiredFlips) {
-			addFlips(addr, identity.ShiftedShardId, identity.FlipCids)
-			c := &candidate{
-				Address:  addr,
-				PubKey:   identity.PubKey,
-				IsAuthor: len(identity.FlipCids) > 0,
-			}
-			shard.candidates = append(shard.candidates, c)
-		} else {
-			shard.nonCandidates = append(shard.nonCandidates, addr)
-		}
+		candidatesDistibution[identity.ShiftedShardId].addLotteryIdentity(identity)
 	}
 
 	var lotteryIdentities []database.DbLotteryIdentity
@@ -757,7 +737,7 @@ func (vc *ValidationCeremony) getCandidatesAndFlips(restore bool) map[common.Sha
 			if err := data.FromBytes(value); err != nil {
 				return false
 			}
-			lotteryIdentity := toDbLotteryIdentity(addr, data)
+			lotteryIdentity := newLotteryIdentity(addr, data)
 			handleIdentity(lotteryIdentity)
 			lotteryIdentities = append(lotteryIdentities, lotteryIdentity)
 			return false
@@ -772,6 +752,54 @@ func (vc *ValidationCeremony) getCandidatesAndFlips(restore bool) map[common.Sha
 	return candidatesDistibution
 }
 
+func newLotteryIdentity(addr common.Address, data state.Identity) database.DbLotteryIdentity {
+	res := database.DbLotteryIdentity{
+		Address:                 addr,
+		ShiftedShardId:          data.ShiftedShardId(),
+		PubKey:                  data.PubKey,
+		State:                   uint8(data.State),
+		HasDoneAllRequiredFlips: data.HasDoneAllRequiredFlips(),
+	}
+	if len(data.Flips) > 0 {
+		res.FlipCids = make([][]byte, 0, len(data.Flips))
+		for _, identityFlip := range data.Flips {
+			res.FlipCids = append(res.FlipCids, identityFlip.Cid)
+		}
+	}
+	return res
+}
+
+func newCandidatesOfShard() *candidatesOfShard {
+	return &candidatesOfShard{
+		candidates:        make([]*candidate, 0),
+		flips:             make([][]byte, 0),
+		flipsPerAuthor:    make(map[int][][]byte),
+		flipAuthorMap:     make(map[string]common.Address),
+		longFlipsToSolve:  map[common.Address][][]byte{},
+		shortFlipsToSolve: map[common.Address][][]byte{},
+		nonCandidates:     make([]common.Address, 0),
+	}
+}
+
+func (shard *candidatesOfShard) addLotteryIdentity(identity database.DbLotteryIdentity) {
+	addr := identity.Address
+	if !state.IsCeremonyCandidateData(state.IdentityState(identity.State), identity.HasDoneAllRequiredFlips) {
+		shard.nonCandidates = append(shard.nonCandidates, addr)
+		return
+	}
+	authorIndex := len(shard.candidates)
+	for _, flipCid := range identity.FlipCids {
+		shard.flips = append(shard.flips, flipCid)
+		shard.flipsPerAuthor[authorIndex] = append(shard.flipsPerAuthor[authorIndex], flipCid)
+		shard.flipAuthorMap[string(flipCid)] = addr
+	}
+	shard.candidates = append(shard.candidates, &candidate{
+		Address:  addr,
+		PubKey:   identity.PubKey,
+		IsAuthor: len(identity.FlipCids) > 0,
+	})
+}
+
 func (vc *ValidationCeremony) getCandidatesAddresses(shardId common.ShardId) []common.Address {
 	var result []common.Address
 	for _, p := range vc.shardCandidates[shardId].candidates {
@@ -1063,6 +1091,7 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 	validationResults := map[common.ShardId]*types.ValidationResults{}
 	god := appState.State.GodAddress()
 	allGoodInviters := make(map[common.Address]*types.InviterValidationResult)
+	identityResults := make(map[common.Address]*IdentityValidationResult)
 	var isGodCeremonyCandidate bool
 	isGodUndefined := appState.State.GetIdentity(god).State == state.Undefined
 	for shardId := common.ShardId(1); shardId <= common.ShardId(len(vc.shardCandidates)); shardId++ {
@@ -1132,8 +1161,40 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 			totalScore, totalFlips = calculateNewTotalScore(appState.State.GetScores(addr), shortFlipPoint, shortQualifiedFlipsCount, totalFlipPoints, totalQualifiedFlipsCount)
 
 			identity := appState.State.GetIdentity(addr)
-			newIdentityState := determineNewIdentityState(identity, shortScore, longScore, totalScore,
+			newIdentityState, rule := determineNewIdentityStateWithRule(identity, shortScore, longScore, totalScore,
 				totalFlips, missed, noQualShort, noQualLong, vc.config.Consensus.EnableUpgrade8)
+			identityResult := &IdentityValidationResult{
+				Epoch:                   vc.epoch,
+				ShardId:                 shardId,
+				PrevState:               identity.State,
+				NewState:                newIdentityState,
+				Rule:                    rule,
+				HasDoneAllRequiredFlips: identity.HasDoneAllRequiredFlips(),
+				Candidate:               true,
+				Approved:                approved,
+				Missed:                  missed,
+				NoAnswersShort:          noAnswersShort,
+				NoAnswersLong:           noAnswersLong,
+				NoQualifiedShort:        noQualShort,
+				NoQualifiedLong:         noQualLong,
+				ShortPoint:              shortFlipPoint,
+				ShortQualifiedFlips:     shortQualifiedFlipsCount,
+				ShortScore:              shortScore,
+				LongPoint:               longFlipPoint,
+				LongQualifiedFlips:      longQualifiedFlipsCount,
+				LongScore:               longScore,
+				PrevTotalPoints:         totalFlipPoints,
+				PrevTotalQualifiedFlips: totalQualifiedFlipsCount,
+				TotalScore:              finiteScore(totalScore),
+				TotalQualifiedFlips:     totalFlips,
+				ShortFlips:              newFlipValidationResults(shard.flips, shortFlipsToSolve, flipQualificationMap, shortFlipAnswers, notApprovedFlips),
+				LongFlips:               newFlipValidationResults(shard.flips, longFlipsToSolve, flipQualificationMap, longFlipAnswers, nil),
+				AuthorResults:           shardValidationResults.AuthorResults[addr],
+			}
+			if reason, ok := shardValidationResults.BadAuthors[addr]; ok {
+				identityResult.BadAuthor = &reason
+			}
+			identityResults[addr] = identityResult
 			identityBirthday := determineIdentityBirthday(vc.epoch, identity, newIdentityState)
 
 			incSuccessfulInvites(shardValidationResults, god, identity, identityBirthday, newIdentityState, vc.epoch, allGoodInviters, vc.config.Consensus.EnableUpgrade7)
@@ -1206,7 +1267,16 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 	for _, shard := range vc.shardCandidates {
 		for _, addr := range shard.nonCandidates {
 			identity := appState.State.GetIdentity(addr)
-			newIdentityState := determineNewIdentityState(identity, 0, 0, 0, 0, true, false, false, vc.config.Consensus.EnableUpgrade8)
+			newIdentityState, rule := determineNewIdentityStateWithRule(identity, 0, 0, 0, 0, true, false, false, vc.config.Consensus.EnableUpgrade8)
+			identityResults[addr] = &IdentityValidationResult{
+				Epoch:                   vc.epoch,
+				ShardId:                 identity.ShiftedShardId(),
+				PrevState:               identity.State,
+				NewState:                newIdentityState,
+				Rule:                    rule,
+				HasDoneAllRequiredFlips: identity.HasDoneAllRequiredFlips(),
+				Missed:                  true,
+			}
 			identityBirthday := determineIdentityBirthday(vc.epoch, identity, newIdentityState)
 
 			value := cacheValue{
@@ -1223,6 +1293,8 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 		}
 	}
 
+	vc.writeValidationResults(identityResults)
+
 	vc.epochApplyingCache[height] = epochApplyingCache{
 		epochApplyingResult: epochApplyingValues,
 		validationResults:   validationResults,
@@ -1462,13 +1534,19 @@ func determineIdentityBirthday(currentEpoch uint16, identity state.Identity, new
 }
 
 func determineNewIdentityState(identity state.Identity, shortScore, longScore, totalScore float32, totalQualifiedFlips uint32, missed, noQualShort, nonQualLong, enableUpgrade8 bool) state.IdentityState {
+	newState, _ := determineNewIdentityStateWithRule(identity, shortScore, longScore, totalScore, totalQualifiedFlips, missed, noQualShort, nonQualLong, enableUpgrade8)
+	return newState
+}
+
+// determineNewIdentityStateWithRule returns the new identity state along with the rule which decided it
+func determineNewIdentityStateWithRule(identity state.Identity, shortScore, longScore, totalScore float32, totalQualifiedFlips uint32, missed, noQualShort, nonQualLong, enableUpgrade8 bool) (state.IdentityState, ValidationRule) {
 
 	if !identity.HasDoneAllRequiredFlips() {
 		switch identity.State {
 		case state.Verified, state.Human:
-			return state.Suspended
+			return state.Suspended, RuleRequiredFlipsNotSubmitted
 		default:
-			return state.Killed
+			return state.Killed, RuleRequiredFlipsNotSubmitted
 		}
 	}
 
@@ -1476,102 +1554,119 @@ func determineNewIdentityState(identity state.Identity, shortScore, longScore, t
 
 	switch prevState {
 	case state.Undefined:
-		return state.Undefined
+		return state.Undefined, RuleNotValidating
 	case state.Invite:
-		return state.Killed
+		return state.Killed, RuleInviteNotActivated
 	case state.Candidate:
 		if missed {
-			return state.Killed
+			return state.Killed, RuleMissed
+		}
+		if noQualShort {
+			return state.Candidate, RuleNoQualifiedShortFlips
 		}
-		if noQualShort || nonQualLong && shortScore >= common.MinShortScore {
-			return state.Candidate
+		if nonQualLong && shortScore >= common.MinShortScore {
+			return state.Candidate, RuleNoQualifiedLongFlips
 		}
 		if shortScore < common.MinShortScore || longScore < common.MinLongScore {
-			return state.Killed
+			return state.Killed, RuleCriteriaNotMet
 		}
-		return state.Newbie
+		return state.Newbie, RuleNewbieCriteria
 	case state.Newbie:
 		if missed {
-			return state.Killed
+			return state.Killed, RuleMissed
 		}
-		if noQualShort ||
-			nonQualLong && totalQualifiedFlips >= common.MinFlipsForVerified && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore ||
+		if noQualShort {
+			return state.Newbie, RuleNoQualifiedShortFlips
+		}
+		if nonQualLong && totalQualifiedFlips >= common.MinFlipsForVerified && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore ||
 			nonQualLong && totalQualifiedFlips < common.MinFlipsForVerified && shortScore >= common.MinShortScore {
-			return state.Newbie
+			return state.Newbie, RuleNoQualifiedLongFlips
 		}
 		if totalQualifiedFlips >= common.MinFlipsForVerified && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Verified
+			return state.Verified, RuleVerifiedCriteria
 		}
 		if totalQualifiedFlips < common.MinFlipsForVerified && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Newbie
+			return state.Newbie, RuleNewbieCriteria
 		}
-		return state.Killed
+		return state.Killed, RuleCriteriaNotMet
 	case state.Verified:
 		if missed {
-			return state.Suspended
+			return state.Suspended, RuleMissed
+		}
+		if noQualShort {
+			return state.Verified, RuleNoQualifiedShortFlips
 		}
-		if noQualShort || nonQualLong && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
-			return state.Verified
+		if nonQualLong && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
+			return state.Verified, RuleNoQualifiedLongFlips
 		}
 		if totalQualifiedFlips >= common.MinFlipsForHuman && totalScore >= common.MinHumanTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Human
+			return state.Human, RuleHumanCriteria
 		}
 		if totalQualifiedFlips >= common.MinFlipsForVerified && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Verified
+			return state.Verified, RuleVerifiedCriteria
 		}
-		return state.Killed
+		return state.Killed, RuleCriteriaNotMet
 	case state.Suspended:
 		if missed {
-			return state.Zombie
+			return state.Zombie, RuleMissed
 		}
-		if noQualShort || nonQualLong && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
-			return state.Suspended
+		if noQualShort {
+			return state.Suspended, RuleNoQualifiedShortFlips
+		}
+		if nonQualLong && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
+			return state.Suspended, RuleNoQualifiedLongFlips
 		}
 		if totalQualifiedFlips >= common.MinFlipsForHuman && totalScore >= common.MinHumanTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Human
+			return state.Human, RuleHumanCriteria
 		}
 		if totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Verified
+			return state.Verified, RuleVerifiedCriteria
 		}
-		return state.Killed
+		return state.Killed, RuleCriteriaNotMet
 	case state.Zombie:
 		if missed {
-			return state.Killed
+			return state.Killed, RuleMissed
+		}
+		if noQualShort {
+			return state.Zombie, RuleNoQualifiedShortFlips
 		}
-		if noQualShort || nonQualLong && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
-			return state.Zombie
+		if nonQualLong && totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
+			return state.Zombie, RuleNoQualifiedLongFlips
 		}
 		if totalQualifiedFlips >= common.MinFlipsForHuman && totalScore >= common.MinHumanTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Human
+			return state.Human, RuleHumanCriteria
 		}
 		if totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore {
-			return state.Verified
+			return state.Verified, RuleVerifiedCriteria
 		}
-		return state.Killed
+		return state.Killed, RuleCriteriaNotMet
 	case state.Human:
 		if missed {
-			return state.Suspended
+			return state.Suspended, RuleMissed
+		}
+		if noQualShort {
+			return state.Human, RuleNoQualifiedShortFlips
 		}
-		if noQualShort || nonQualLong && totalScore >= common.MinHumanTotalScore && shortScore >= common.MinShortScore {
-			return state.Human
+		if nonQualLong && totalScore >= common.MinHumanTotalScore && shortScore >= common.MinShortScore {
+			return state.Human, RuleNoQualifiedLongFlips
 		}
 		if nonQualLong {
-			return state.Suspended
+			return state.Suspended, RuleNoQualifiedLongFlips
 		}
 		if totalScore >= common.MinHumanTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Human
+			return state.Human, RuleHumanCriteria
 		}
 		if totalScore >= common.MinTotalScore && shortScore >= common.MinShortScore && longScore >= common.MinLongScore {
-			return state.Verified
+			return state.Verified, RuleVerifiedCriteria
 		}
 		if enableUpgrade8 || totalScore >= common.MinTotalScore {
-			return state.Suspended
+			return state.Suspended, RuleHumanDowngrade
 		}
-		return state.Killed
+		return state.Killed, RuleCriteriaNotMet
 	case state.Killed:
-		return state.Killed
+		return state.Killed, RuleNotValidating
 	}
-	return state.Undefined
+	return state.Undefined, RuleNotValidating
 }
 
 func (vc *ValidationCeremony) FlipKeyWordPairs() []int {
@@ -1808,6 +1903,59 @@ func (vc *ValidationCeremony) IsValidationReady() bool {
 	return vc.lottery.finished
 }
 
+// Status returns the ceremony phase, counts of flip keys, answers and evidence maps received in the current epoch
+// and the readiness of the node coinbase to solve its flips
+func (vc *ValidationCeremony) Status() *CeremonyStatus {
+	period := vc.appState.State.ValidationPeriod()
+	res := &CeremonyStatus{
+		Epoch:                   vc.appState.State.Epoch(),
+		Period:                  period,
+		Phase:                   ceremonyPhase(period),
+		PublicFlipKeys:          vc.keysPool.PublicFlipKeysCount(),
+		PrivateFlipKeysPackages: vc.keysPool.PrivateFlipKeysPackagesCount(),
+		AnswerHashes:            vc.epochDb.AnswerHashesCount(),
+		EvidenceMaps:            vc.epochDb.EvidenceMapsCount(),
+		ValidationReady:         vc.IsValidationReady(),
+	}
+	res.ShortAnswers, res.LongAnswers = vc.qualification.answersCount()
+
+	addr := vc.secStore.GetAddress()
+	identity := vc.appState.State.GetIdentity(addr)
+	res.Candidate = state.IsCeremonyCandidate(identity)
+	if !res.Candidate || !res.ValidationReady {
+		return res
+	}
+	countFlips := func(flips [][]byte) (count int, loaded int, ready int) {
+		for _, flip := range flips {
+			if vc.IsFlipInMemory(flip) {
+				loaded++
+			}
+			if vc.IsFlipReadyToSolve(flip) {
+				ready++
+			}
+		}
+		return len(flips), loaded, ready
+	}
+	res.ShortFlips, res.ShortFlipsLoaded, res.ShortFlipsReady = countFlips(vc.GetShortFlipsToSolve(addr, identity.ShiftedShardId()))
+	res.LongFlips, res.LongFlipsLoaded, res.LongFlipsReady = countFlips(vc.GetLongFlipsToSolve(addr, identity.ShiftedShardId()))
+	return res
+}
+
+func ceremonyPhase(period state.ValidationPeriod) types.BlockFlag {
+	switch period {
+	case state.FlipLotteryPeriod:
+		return types.FlipLotteryStarted
+	case state.ShortSessionPeriod:
+		return types.ShortSessionStarted
+	case state.LongSessionPeriod:
+		return types.LongSessionStarted
+	case state.AfterLongSessionPeriod:
+		return types.AfterLongSessionStarted
+	default:
+		return 0
+	}
+}
+
 func (vc *ValidationCeremony) getPrivateKeyPackageIndex(addr common.Address, author common.Address) int {
 	addrIndex := vc.getCandidateIndex(addr)
 	authorIndex := vc.getCandidateIndex(author)
@@ -9,8 +9,10 @@ import (
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -315,6 +317,38 @@ func Test_determineNewIdentityState(t *testing.T) {
 	}
 }
 
+func Test_determineNewIdentityStateWithRule(t *testing.T) {
+	cases := []struct {
+		identity      state.Identity
+		shortScore    float32
+		longScore     float32
+		totalScore    float32
+		flips         uint32
+		missed        bool
+		noQualShort   bool
+		noQualLong    bool
+		expectedState state.IdentityState
+		expectedRule  ValidationRule
+	}{
+		{state.Identity{State: state.Verified, RequiredFlips: 3}, 1, 1, 1, 30, false, false, false, state.Suspended, RuleRequiredFlipsNotSubmitted},
+		{state.Identity{State: state.Invite}, 1, 1, 1, 30, false, false, false, state.Killed, RuleInviteNotActivated},
+		{state.Identity{State: state.Suspended}, 1, 1, 1, 30, true, false, false, state.Zombie, RuleMissed},
+		{state.Identity{State: state.Newbie}, 0, 0, 0, 0, false, true, false, state.Newbie, RuleNoQualifiedShortFlips},
+		{state.Identity{State: state.Human}, 0.5, 1, 1, 30, false, false, true, state.Suspended, RuleNoQualifiedLongFlips},
+		{state.Identity{State: state.Candidate}, common.MinShortScore, common.MinLongScore, 0, 6, false, false, false, state.Newbie, RuleNewbieCriteria},
+		{state.Identity{State: state.Newbie}, common.MinShortScore, common.MinLongScore, common.MinTotalScore, 13, false, false, false, state.Verified, RuleVerifiedCriteria},
+		{state.Identity{State: state.Verified}, common.MinShortScore, common.MinLongScore, common.MinHumanTotalScore, 24, false, false, false, state.Human, RuleHumanCriteria},
+		{state.Identity{State: state.Human}, 0, 0, common.MinTotalScore, 24, false, false, false, state.Suspended, RuleHumanDowngrade},
+		{state.Identity{State: state.Verified}, 0.5, 1, 1, 30, false, false, false, state.Killed, RuleCriteriaNotMet},
+	}
+	for i, c := range cases {
+		newState, rule := determineNewIdentityStateWithRule(c.identity, c.shortScore, c.longScore, c.totalScore, c.flips, c.missed, c.noQualShort, c.noQualLong, true)
+		require.Equal(t, c.expectedState, newState, "index = %v", i)
+		require.Equal(t, c.expectedRule, rule, "index = %v", i)
+		require.NotEmpty(t, ValidationRuleDescriptions[rule])
+	}
+}
+
 func Test_getNotApprovedFlips(t *testing.T) {
 	// given
 	vc := ValidationCeremony{}
@@ -1316,3 +1350,48 @@ func Test_determineStakeShareToBurn(t *testing.T) {
 	require.Equal(t, 100, determineStakeShareToBurn(state.Suspended, 14, 14))
 	require.Equal(t, 100, determineStakeShareToBurn(state.Zombie, 14, 14))
 }
+
+func TestValidationCeremony_Status(t *testing.T) {
+	require := require.New(t)
+	db := dbm.NewMemDB()
+	bus := eventbus.New()
+	appState, _ := appstate.NewAppState(db, bus)
+	appState.State.SetGlobalEpoch(3)
+	appState.State.SetValidationPeriod(state.LongSessionPeriod)
+	var addrs []common.Address
+	for i := 0; i < 10; i++ {
+		addr := common.Address{byte(i + 1)}
+		addrs = append(addrs, addr)
+		appState.State.SetState(addr, state.Verified)
+		appState.State.SetRequiredFlips(addr, 1)
+		cid := crypto.Hash(addr.Bytes())
+		appState.State.AddFlip(addr, cid[:], 0)
+	}
+	require.NoError(appState.Commit(nil, true))
+	require.NoError(appState.Initialize(1))
+
+	simulation, err := NewSimulation(appState, &config.Config{Consensus: config.ConsensusVersions[config.ConsensusV8]}, common.Hash{0x1}.Bytes())
+	require.NoError(err)
+	key, _ := crypto.GenerateKey()
+	secStore := secstore.NewSecStore()
+	secStore.AddKey(crypto.FromECDSA(key))
+	vc := simulation.vc
+	vc.secStore = secStore
+	vc.keysPool = mempool.NewKeysPool(db, appState, bus, secStore)
+
+	simulation.SubmitAnswers(addrs[0], types.NewAnswers(6), types.NewAnswers(20))
+	simulation.SubmitAnswers(addrs[1], types.NewAnswers(6), nil)
+	simulation.SubmitEvidence(addrs[0], addrs)
+
+	status := vc.Status()
+	require.Equal(uint16(3), status.Epoch)
+	require.Equal(types.LongSessionStarted, status.Phase)
+	require.Equal(2, status.AnswerHashes)
+	require.Equal(2, status.ShortAnswers)
+	require.Equal(1, status.LongAnswers)
+	require.Equal(1, status.EvidenceMaps)
+	require.Zero(status.PublicFlipKeys)
+	require.True(status.ValidationReady)
+	require.False(status.Candidate)
+	require.Zero(status.ShortFlips)
+}
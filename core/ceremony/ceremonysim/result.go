@@ -0,0 +1,238 @@
+package ceremonysim
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/shopspring/decimal"
+	"math/big"
+)
+
+// Result is the outcome of a simulated validation ceremony, flips are referenced by indexes in Flips
+type Result struct {
+	Name string `json:"name"`
+	// Failed is set if nobody is validated, identities keep their states in this case
+	Failed     bool              `json:"failed"`
+	Groups     []*GroupResult    `json:"groups"`
+	Flips      []*FlipResult     `json:"flips,omitempty"`
+	Identities []*IdentityResult `json:"identities,omitempty"`
+}
+
+type FlipResult struct {
+	Cid     hexutil.Bytes  `json:"cid"`
+	ShardId common.ShardId `json:"shardId"`
+	Author  common.Address `json:"author"`
+	Group   string         `json:"group"`
+	Kind    string         `json:"kind"`
+	// Answer is the correct answer, Status, QualifiedAnswer and Grade are results of the qualification
+	Answer           string `json:"answer"`
+	Status           string `json:"status"`
+	QualifiedAnswer  string `json:"qualifiedAnswer"`
+	Grade            string `json:"grade"`
+	ShortRespondents int    `json:"shortRespondents"`
+	LongRespondents  int    `json:"longRespondents"`
+}
+
+type Reward struct {
+	Balance decimal.Decimal `json:"balance"`
+	Stake   decimal.Decimal `json:"stake"`
+}
+
+type IdentityResult struct {
+	Address             common.Address     `json:"address"`
+	Group               string             `json:"group"`
+	Solver              string             `json:"solver"`
+	PrevState           string             `json:"prevState"`
+	NewState            string             `json:"newState"`
+	Rule                string             `json:"rule,omitempty"`
+	Approved            bool               `json:"approved"`
+	Missed              bool               `json:"missed"`
+	ShortScore          float32            `json:"shortScore"`
+	LongScore           float32            `json:"longScore"`
+	TotalScore          float32            `json:"totalScore"`
+	TotalQualifiedFlips uint32             `json:"totalQualifiedFlips"`
+	BadAuthor           string             `json:"badAuthor,omitempty"`
+	ShortFlips          []int              `json:"shortFlips"`
+	LongFlips           []int              `json:"longFlips"`
+	Rewards             map[string]*Reward `json:"rewards,omitempty"`
+}
+
+// GroupResult summarizes results of identities of a group
+type GroupResult struct {
+	Name   string         `json:"name"`
+	Count  int            `json:"count"`
+	States map[string]int `json:"states"`
+	// BadAuthors is the number of identities penalized for their flips
+	BadAuthors int `json:"badAuthors"`
+	// AvgShortScore and AvgLongScore are average scores of ceremony candidates of the group
+	AvgShortScore float32 `json:"avgShortScore"`
+	AvgLongScore  float32 `json:"avgLongScore"`
+	// Reward is the total reward of the group, AvgReward is the reward per identity
+	Reward    decimal.Decimal `json:"reward"`
+	AvgReward decimal.Decimal `json:"avgReward"`
+}
+
+func (s *simulator) result(simulation *ceremony.Simulation, failed bool, assignments map[common.Address][2][][]byte,
+	prevStates map[common.Address]state.IdentityState, rewards map[common.Address]map[string]*blockchain.EstimatedReward) (*Result, error) {
+	res := &Result{
+		Name:   s.scenario.Name,
+		Failed: failed,
+	}
+
+	flipIndexes := make(map[string]int)
+	stats := simulation.ValidationStats()
+	for shardId := common.ShardId(1); shardId <= common.ShardId(s.appState.State.ShardsNum()); shardId++ {
+		shardStats, ok := stats.Shards[shardId]
+		if !ok {
+			continue
+		}
+		for i, cid := range shardStats.FlipCids {
+			flip := s.flips[string(cid)]
+			flipResult := &FlipResult{
+				Cid:     cid,
+				ShardId: shardId,
+				Author:  flip.author,
+				Group:   flip.group.Name,
+				Kind:    flip.kind,
+				Answer:  answerName(flip.answer),
+			}
+			if flipStats, ok := shardStats.FlipsPerIdx[i]; ok {
+				flipResult.Status = flipStatusName(ceremony.FlipStatus(flipStats.Status))
+				flipResult.QualifiedAnswer = answerName(flipStats.Answer)
+				flipResult.Grade = gradeName(flipStats.Grade)
+				flipResult.ShortRespondents = len(flipStats.ShortAnswers)
+				flipResult.LongRespondents = len(flipStats.LongAnswers)
+			}
+			flipIndexes[string(cid)] = len(res.Flips)
+			res.Flips = append(res.Flips, flipResult)
+		}
+	}
+	toIndexes := func(cids [][]byte) []int {
+		indexes := make([]int, 0, len(cids))
+		for _, cid := range cids {
+			indexes = append(indexes, flipIndexes[string(cid)])
+		}
+		return indexes
+	}
+
+	groups := make(map[*Group]*GroupResult)
+	candidates := make(map[*Group]int)
+	for _, group := range s.scenario.Groups {
+		groupResult := &GroupResult{Name: group.Name, States: map[string]int{}}
+		groups[group] = groupResult
+		res.Groups = append(res.Groups, groupResult)
+	}
+	for _, identity := range s.identities {
+		identityResult := &IdentityResult{
+			Address:    identity.addr,
+			Group:      identity.group.Name,
+			Solver:     identity.solver.Type,
+			PrevState:  prevStates[identity.addr].String(),
+			NewState:   prevStates[identity.addr].String(),
+			ShortFlips: toIndexes(assignments[identity.addr][0]),
+			LongFlips:  toIndexes(assignments[identity.addr][1]),
+		}
+		validationResult, err := simulation.ValidationResult(identity.addr)
+		if err != nil {
+			return nil, err
+		}
+		groupResult := groups[identity.group]
+		if validationResult != nil {
+			identityResult.NewState = validationResult.NewState.String()
+			identityResult.Rule = string(validationResult.Rule)
+			identityResult.Approved = validationResult.Approved
+			identityResult.Missed = validationResult.Missed
+			identityResult.ShortScore = validationResult.ShortScore
+			identityResult.LongScore = validationResult.LongScore
+			identityResult.TotalScore = validationResult.TotalScore
+			identityResult.TotalQualifiedFlips = validationResult.TotalQualifiedFlips
+			if validationResult.BadAuthor != nil {
+				identityResult.BadAuthor = badAuthorReasonName(*validationResult.BadAuthor)
+				groupResult.BadAuthors++
+			}
+			if validationResult.Candidate {
+				groupResult.AvgShortScore += validationResult.ShortScore
+				groupResult.AvgLongScore += validationResult.LongScore
+				candidates[identity.group]++
+			}
+		}
+		total := new(big.Int)
+		if identityRewards := rewards[identity.addr]; len(identityRewards) > 0 {
+			identityResult.Rewards = make(map[string]*Reward, len(identityRewards))
+			for rewardType, reward := range identityRewards {
+				identityResult.Rewards[rewardType] = &Reward{
+					Balance: blockchain.ConvertToFloat(reward.Balance),
+					Stake:   blockchain.ConvertToFloat(reward.Stake),
+				}
+				total.Add(total, reward.Balance)
+				total.Add(total, reward.Stake)
+			}
+		}
+		groupResult.Count++
+		groupResult.States[identityResult.NewState]++
+		groupResult.Reward = groupResult.Reward.Add(blockchain.ConvertToFloat(total))
+		res.Identities = append(res.Identities, identityResult)
+	}
+	for group, groupResult := range groups {
+		if cnt := candidates[group]; cnt > 0 {
+			groupResult.AvgShortScore /= float32(cnt)
+			groupResult.AvgLongScore /= float32(cnt)
+		}
+		if groupResult.Count > 0 {
+			groupResult.AvgReward = groupResult.Reward.Div(decimal.NewFromInt(int64(groupResult.Count)))
+		}
+	}
+	return res, nil
+}
+
+func flipStatusName(status ceremony.FlipStatus) string {
+	switch status {
+	case ceremony.Qualified:
+		return "Qualified"
+	case ceremony.WeaklyQualified:
+		return "WeaklyQualified"
+	case ceremony.QualifiedByNone:
+		return "QualifiedByNone"
+	default:
+		return "NotQualified"
+	}
+}
+
+func answerName(answer types.Answer) string {
+	switch answer {
+	case types.Left:
+		return "Left"
+	case types.Right:
+		return "Right"
+	default:
+		return "None"
+	}
+}
+
+func gradeName(grade types.Grade) string {
+	if grade == types.GradeReported {
+		return "Reported"
+	}
+	for name, value := range grades {
+		if value == grade && name != "" {
+			return name
+		}
+	}
+	return "None"
+}
+
+func badAuthorReasonName(reason types.BadAuthorReason) string {
+	switch reason {
+	case types.NoQualifiedFlipsBadAuthor:
+		return "NoQualifiedFlips"
+	case types.QualifiedByNoneBadAuthor:
+		return "QualifiedByNone"
+	case types.WrongWordsBadAuthor:
+		return "WrongWords"
+	default:
+		return "Unknown"
+	}
+}
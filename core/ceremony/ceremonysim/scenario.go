@@ -0,0 +1,429 @@
+// Package ceremonysim simulates a validation ceremony of a test network without a node.
+// A scenario describes groups of identities, their flips and solvers, the simulation runs the flip lottery,
+// qualification and rewards of ceremony.Simulation and reports the flip assignment, new identity states and rewards.
+package ceremonysim
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	dbm "github.com/tendermint/tm-db"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"strconv"
+)
+
+const (
+	SolverHonest      = "honest"
+	SolverRandom      = "random"
+	SolverAdversarial = "adversarial"
+	SolverNone        = "none"
+
+	FlipGood      = "good"
+	FlipReported  = "reported"
+	FlipAmbiguous = "ambiguous"
+
+	defaultEpoch         = 10
+	defaultEpochDuration = 28800
+)
+
+var grades = map[string]types.Grade{
+	"":  types.GradeNone,
+	"D": types.GradeD,
+	"C": types.GradeC,
+	"B": types.GradeB,
+	"A": types.GradeA,
+}
+
+// Scenario describes identities of a test network and how they solve flips of the validation ceremony
+type Scenario struct {
+	Name string `json:"name"`
+	// Seed is the seed of addresses, flip answers, solvers and the flip lottery
+	Seed  int64  `json:"seed"`
+	Epoch uint16 `json:"epoch"`
+	// ConsensusVersion is the latest one by default, Consensus overrides parameters of the version,
+	// e.g. {"FlipRewardPercent": 0.2, "EnableUpgrade8": false}
+	ConsensusVersion config.ConsensusVerson `json:"consensusVersion"`
+	Consensus        json.RawMessage        `json:"consensus,omitempty"`
+	// EpochDuration is the epoch duration in blocks which determines the total reward
+	EpochDuration uint32   `json:"epochDuration"`
+	Groups        []*Group `json:"groups"`
+}
+
+// Group is a number of identities with the same state, flips and solver
+type Group struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+	// State is the identity state before the validation, Verified by default
+	State string `json:"state"`
+	// Age is the number of epochs since the identity birthday
+	Age uint16 `json:"age"`
+	// Stake is the identity stake in DNA
+	Stake string `json:"stake"`
+	// Flips is the number of flips made by each identity, RequiredFlips is equal to Flips if not set
+	Flips         int  `json:"flips"`
+	RequiredFlips *int `json:"requiredFlips,omitempty"`
+	// ReportedFlips of the flips have inappropriate content or words and are reported by honest solvers
+	ReportedFlips int `json:"reportedFlips"`
+	// AmbiguousFlips of the flips have no obvious answer and are solved randomly by honest solvers
+	AmbiguousFlips int `json:"ambiguousFlips"`
+	// PrevValidations is the number of previous validations with PrevScore which make up the total score,
+	// it is equal to Age by default. PrevScore is 1 by default
+	PrevValidations *int     `json:"prevValidations,omitempty"`
+	PrevScore       *float32 `json:"prevScore,omitempty"`
+	// NotApproved identities are not approved by evidence maps of others, e.g. flip keys were not published
+	NotApproved bool    `json:"notApproved"`
+	Solver      *Solver `json:"solver"`
+}
+
+// Solver describes answers of identities of a group
+type Solver struct {
+	// Type is one of honest, random, adversarial and none, honest by default.
+	// Honest solvers answer correctly with the given accuracy, random solvers choose left or right by chance,
+	// adversarial solvers answer incorrectly and none solvers do not submit answers
+	Type string `json:"type"`
+	// Accuracy is the probability of a correct answer of an honest solver, 1 by default
+	Accuracy *float64 `json:"accuracy,omitempty"`
+	// Grade is the grade of long session flips given by honest solvers, one of A, B, C and D, flips are not graded if empty
+	Grade string `json:"grade"`
+	// Reports is the share of long session flips reported by an adversarial solver regardless of flip content
+	Reports float64 `json:"reports"`
+	// ShortOnly solvers do not submit long session answers
+	ShortOnly bool `json:"shortOnly"`
+}
+
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	scenario := new(Scenario)
+	if err := json.Unmarshal(data, scenario); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse scenario %v", path)
+	}
+	if scenario.Name == "" {
+		scenario.Name = path
+	}
+	return scenario, nil
+}
+
+type simIdentity struct {
+	addr   common.Address
+	group  *Group
+	solver *Solver
+}
+
+type simFlip struct {
+	author common.Address
+	group  *Group
+	kind   string
+	answer types.Answer
+}
+
+type simulator struct {
+	scenario   *Scenario
+	conf       *config.Config
+	rnd        *rand.Rand
+	appState   *appstate.AppState
+	identities []*simIdentity
+	flips      map[string]*simFlip
+}
+
+// Run simulates the validation ceremony of the scenario
+func (s *Scenario) Run() (*Result, error) {
+	conf, err := s.consensusConfig()
+	if err != nil {
+		return nil, err
+	}
+	sim := &simulator{
+		scenario: s,
+		conf:     &config.Config{Consensus: conf},
+		rnd:      rand.New(rand.NewSource(s.Seed)),
+		flips:    make(map[string]*simFlip),
+	}
+	if err := sim.buildState(); err != nil {
+		return nil, err
+	}
+	return sim.run()
+}
+
+func (s *Scenario) consensusConfig() (*config.ConsensusConf, error) {
+	version := s.ConsensusVersion
+	if version == 0 {
+		version = config.ConsensusV8
+	}
+	consensus, ok := config.ConsensusVersions[version]
+	if !ok {
+		return nil, errors.Errorf("unknown consensus version %v", version)
+	}
+	conf := *consensus
+	if len(s.Consensus) > 0 {
+		if err := json.Unmarshal(s.Consensus, &conf); err != nil {
+			return nil, errors.Wrap(err, "failed to parse consensus parameters")
+		}
+	}
+	return &conf, nil
+}
+
+func (s *simulator) epoch() uint16 {
+	if s.scenario.Epoch == 0 {
+		return defaultEpoch
+	}
+	return s.scenario.Epoch
+}
+
+func (s *simulator) buildState() error {
+	appState, err := appstate.NewAppState(dbm.NewMemDB(), eventbus.New())
+	if err != nil {
+		return err
+	}
+	epoch := s.epoch()
+	appState.State.SetGlobalEpoch(epoch)
+	appState.State.SetEpochBlock(1)
+
+	for groupIdx, group := range s.scenario.Groups {
+		if group.Name == "" {
+			group.Name = groupName(groupIdx)
+		}
+		identityState := state.Verified
+		if group.State != "" {
+			var err error
+			if identityState, err = state.ParseIdentityState(group.State); err != nil || !identityState.IsInShard() {
+				return errors.Errorf("group %v: unknown identity state %v", group.Name, group.State)
+			}
+		}
+		if group.ReportedFlips+group.AmbiguousFlips > group.Flips {
+			return errors.Errorf("group %v: reported and ambiguous flips exceed flips", group.Name)
+		}
+		requiredFlips := group.Flips
+		if group.RequiredFlips != nil {
+			requiredFlips = *group.RequiredFlips
+		}
+		if group.Age > epoch {
+			return errors.Errorf("group %v: age exceeds epoch", group.Name)
+		}
+		var stake *big.Int
+		if group.Stake != "" {
+			d, err := decimal.NewFromString(group.Stake)
+			if err != nil {
+				return errors.Errorf("group %v: cannot parse stake %v", group.Name, group.Stake)
+			}
+			stake = blockchain.ConvertToInt(d)
+		}
+		prevValidations := int(group.Age)
+		if group.PrevValidations != nil {
+			prevValidations = *group.PrevValidations
+		}
+		if identityState == state.Candidate {
+			prevValidations = 0
+		}
+		prevScore := float32(1)
+		if group.PrevScore != nil {
+			prevScore = *group.PrevScore
+		}
+		if prevScore < 0 || prevScore > 1 {
+			return errors.Errorf("group %v: score should be from 0 to 1", group.Name)
+		}
+		shortFlips := float32(common.ShortSessionFlipsCount())
+		score := common.EncodeScore(prevScore*shortFlips, uint32(shortFlips))
+		solver := &Solver{}
+		if group.Solver != nil {
+			*solver = *group.Solver
+		}
+		switch solver.Type {
+		case "":
+			solver.Type = SolverHonest
+		case SolverHonest, SolverRandom, SolverAdversarial, SolverNone:
+		default:
+			return errors.Errorf("group %v: unknown solver %v", group.Name, solver.Type)
+		}
+		if _, ok := grades[solver.Grade]; !ok {
+			return errors.Errorf("group %v: unknown grade %v", group.Name, solver.Grade)
+		}
+
+		for i := 0; i < group.Count; i++ {
+			var addr common.Address
+			s.rnd.Read(addr[:])
+			appState.State.SetState(addr, identityState)
+			appState.State.SetBirthday(addr, epoch-group.Age)
+			appState.State.SetRequiredFlips(addr, uint8(requiredFlips))
+			if stake != nil {
+				appState.State.AddStake(addr, stake)
+			}
+			if identityState.NewbieOrBetter() {
+				appState.IdentityState.SetValidated(addr, true)
+			}
+			for j := 0; j < prevValidations && j < common.LastScoresCount; j++ {
+				appState.State.AddNewScore(addr, score, s.conf.Consensus.EnableUpgrade8)
+			}
+			for j := 0; j < group.Flips; j++ {
+				cid := crypto.Hash(append(addr.Bytes(), byte(j)))
+				flip := &simFlip{author: addr, group: group, kind: FlipGood, answer: types.Left}
+				if s.rnd.Intn(2) == 1 {
+					flip.answer = types.Right
+				}
+				switch {
+				case j < group.ReportedFlips:
+					flip.kind = FlipReported
+				case j < group.ReportedFlips+group.AmbiguousFlips:
+					flip.kind = FlipAmbiguous
+				}
+				s.flips[string(cid[:])] = flip
+				appState.State.AddFlip(addr, cid[:], 0)
+			}
+			s.identities = append(s.identities, &simIdentity{addr: addr, group: group, solver: solver})
+		}
+	}
+	if err := appState.Commit(nil, true); err != nil {
+		return err
+	}
+	if err := appState.Initialize(1); err != nil {
+		return err
+	}
+	s.appState = appState
+	return nil
+}
+
+func (s *simulator) run() (*Result, error) {
+	seed := make([]byte, 32)
+	binary.LittleEndian.PutUint64(seed, uint64(s.scenario.Seed))
+	simulation, err := ceremony.NewSimulation(s.appState, s.conf, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make(map[common.Address][2][][]byte)
+	for _, identity := range s.identities {
+		short, long := simulation.FlipsToSolve(identity.addr)
+		if short == nil && long == nil {
+			continue
+		}
+		assignments[identity.addr] = [2][][]byte{short, long}
+		if identity.solver.Type == SolverNone {
+			continue
+		}
+		shortAnswers := s.answer(identity.solver, short, true)
+		var longAnswers *types.Answers
+		if !identity.solver.ShortOnly {
+			longAnswers = s.answer(identity.solver, long, false)
+		}
+		simulation.SubmitAnswers(identity.addr, shortAnswers, longAnswers)
+	}
+
+	approved := make(map[common.ShardId][]common.Address)
+	for _, identity := range s.identities {
+		if _, ok := assignments[identity.addr]; !ok || identity.group.NotApproved || identity.solver.Type == SolverNone {
+			continue
+		}
+		shardId := s.shardId(identity.addr)
+		approved[shardId] = append(approved[shardId], identity.addr)
+	}
+	for _, identity := range s.identities {
+		if _, ok := assignments[identity.addr]; !ok || identity.solver.Type == SolverNone {
+			continue
+		}
+		simulation.SubmitEvidence(identity.addr, approved[s.shardId(identity.addr)])
+	}
+
+	prevStates := make(map[common.Address]state.IdentityState, len(s.identities))
+	for _, identity := range s.identities {
+		prevStates[identity.addr] = s.appState.State.GetIdentity(identity.addr).State
+	}
+
+	epochDuration := s.scenario.EpochDuration
+	if epochDuration == 0 {
+		epochDuration = defaultEpochDuration
+	}
+	height := uint64(1 + epochDuration)
+	validationResult := simulation.ApplyNewEpoch(height, collector.NewStatsCollector())
+	var rewards map[common.Address]map[string]*blockchain.EstimatedReward
+	if !validationResult.Failed {
+		rewards = blockchain.CalculateValidationRewards(s.appState, s.conf.Consensus, validationResult.ShardResults,
+			blockchain.EpochDurations(s.appState, height))
+	}
+	return s.result(simulation, validationResult.Failed, assignments, prevStates, rewards)
+}
+
+func (s *simulator) shardId(addr common.Address) common.ShardId {
+	identity := s.appState.State.GetIdentity(addr)
+	return identity.ShiftedShardId()
+}
+
+// answer returns answers of the solver to the flips, extra flips of the short session are not answered
+func (s *simulator) answer(solver *Solver, flips [][]byte, short bool) *types.Answers {
+	answers := types.NewAnswers(uint(len(flips)))
+	accuracy := float64(1)
+	if solver.Accuracy != nil {
+		accuracy = *solver.Accuracy
+	}
+	for i, cid := range flips {
+		if short && i >= int(common.ShortSessionFlipsCount()) {
+			break
+		}
+		flip, ok := s.flips[string(cid)]
+		if !ok {
+			continue
+		}
+		answer := flip.answer
+		grade := types.GradeNone
+		switch solver.Type {
+		case SolverHonest:
+			if flip.kind == FlipAmbiguous {
+				answer = randomAnswer(s.rnd)
+			} else if s.rnd.Float64() >= accuracy {
+				answer = oppositeAnswer(answer)
+			}
+			if flip.kind == FlipReported {
+				grade = types.GradeReported
+			} else {
+				grade = grades[solver.Grade]
+			}
+		case SolverRandom:
+			answer = randomAnswer(s.rnd)
+		case SolverAdversarial:
+			answer = oppositeAnswer(answer)
+			if s.rnd.Float64() < solver.Reports {
+				grade = types.GradeReported
+			}
+		}
+		if answer == types.Left {
+			answers.Left(uint(i))
+		} else {
+			answers.Right(uint(i))
+		}
+		if !short && grade != types.GradeNone {
+			answers.Grade(uint(i), grade)
+		}
+	}
+	return answers
+}
+
+func randomAnswer(rnd *rand.Rand) types.Answer {
+	if rnd.Intn(2) == 0 {
+		return types.Left
+	}
+	return types.Right
+}
+
+func oppositeAnswer(answer types.Answer) types.Answer {
+	if answer == types.Left {
+		return types.Right
+	}
+	return types.Left
+}
+
+func groupName(idx int) string {
+	return "group" + strconv.Itoa(idx)
+}
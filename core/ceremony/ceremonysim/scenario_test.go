@@ -0,0 +1,148 @@
+package ceremonysim
+
+import (
+	"encoding/json"
+	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+)
+
+func TestScenarios(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		scenario, err := LoadScenario(file)
+		require.NoError(t, err)
+		t.Run(scenario.Name, func(t *testing.T) {
+			res, err := scenario.Run()
+			require.NoError(t, err)
+			require.False(t, res.Failed)
+		})
+	}
+}
+
+func groupResult(res *Result, name string) *GroupResult {
+	for _, group := range res.Groups {
+		if group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func TestScenario_Run(t *testing.T) {
+	accuracy := 0.95
+	newScenario := func() *Scenario {
+		return &Scenario{
+			Seed: 3,
+			Groups: []*Group{
+				{Name: "honest", Count: 20, Age: 10, Stake: "100", Flips: 3, Solver: &Solver{Accuracy: &accuracy, Grade: "A"}},
+				{Name: "random", Count: 4, State: "Newbie", Age: 2, Flips: 3, Solver: &Solver{Type: SolverRandom}},
+				{Name: "absent", Count: 2, State: "Human", Age: 9, Flips: 3, Solver: &Solver{Type: SolverNone}},
+				{Name: "candidates", Count: 3, State: "Candidate"},
+			},
+		}
+	}
+
+	res, err := newScenario().Run()
+	require.NoError(t, err)
+	require.False(t, res.Failed)
+	require.Len(t, res.Flips, 26*3)
+	require.Len(t, res.Identities, 29)
+
+	require.Equal(t, map[string]int{"Human": 20}, groupResult(res, "honest").States)
+	require.Equal(t, map[string]int{"Killed": 4}, groupResult(res, "random").States)
+	require.Equal(t, map[string]int{"Suspended": 2}, groupResult(res, "absent").States)
+	require.Equal(t, map[string]int{"Newbie": 3}, groupResult(res, "candidates").States)
+	require.True(t, groupResult(res, "honest").AvgShortScore > groupResult(res, "random").AvgShortScore)
+
+	for _, identity := range res.Identities {
+		require.Len(t, identity.ShortFlips, 8)
+		require.NotEmpty(t, identity.LongFlips)
+		switch identity.Group {
+		case "honest":
+			require.Equal(t, string(ceremony.RuleHumanCriteria), identity.Rule)
+			require.Contains(t, identity.Rewards, collector.RewardFlips)
+			require.Contains(t, identity.Rewards, collector.RewardStaking)
+		case "absent":
+			require.Equal(t, string(ceremony.RuleMissed), identity.Rule)
+			require.Empty(t, identity.Rewards)
+		case "candidates":
+			require.Contains(t, identity.Rewards, collector.RewardCandidate)
+		}
+	}
+	for _, flip := range res.Flips {
+		if flip.Group == "honest" {
+			require.Contains(t, []string{"Qualified", "WeaklyQualified"}, flip.Status)
+			require.Equal(t, flip.Answer, flip.QualifiedAnswer)
+			require.Equal(t, "A", flip.Grade)
+		}
+	}
+
+	// the same seed gives the same results
+	res2, err := newScenario().Run()
+	require.NoError(t, err)
+	require.Equal(t, res, res2)
+
+	// reward parameters can be changed
+	scenario := newScenario()
+	scenario.Consensus = json.RawMessage(`{"FlipRewardPercent": 0}`)
+	res, err = scenario.Run()
+	require.NoError(t, err)
+	for _, identity := range res.Identities {
+		if reward, ok := identity.Rewards[collector.RewardFlips]; ok {
+			require.True(t, reward.Balance.IsZero())
+			require.True(t, reward.Stake.IsZero())
+		}
+	}
+	require.True(t, groupResult(res, "honest").Reward.LessThan(groupResult(res2, "honest").Reward))
+}
+
+func TestScenario_Run_reportedFlips(t *testing.T) {
+	res, err := (&Scenario{
+		Seed: 5,
+		Groups: []*Group{
+			{Name: "honest", Count: 20, Age: 5, Flips: 3},
+			{Name: "bad authors", Count: 3, Age: 5, Flips: 3, ReportedFlips: 1},
+		},
+	}).Run()
+	require.NoError(t, err)
+	require.Equal(t, 3, groupResult(res, "bad authors").BadAuthors)
+	require.Zero(t, groupResult(res, "honest").BadAuthors)
+	for _, identity := range res.Identities {
+		if identity.Group == "bad authors" {
+			require.Equal(t, "WrongWords", identity.BadAuthor)
+			require.NotContains(t, identity.Rewards, collector.RewardFlips)
+		}
+	}
+	for _, flip := range res.Flips {
+		if flip.Kind == FlipReported {
+			require.Equal(t, "Reported", flip.Grade)
+		}
+	}
+}
+
+func TestScenario_Run_failed(t *testing.T) {
+	res, err := (&Scenario{
+		Groups: []*Group{
+			{Count: 10, Flips: 3, Solver: &Solver{Type: SolverNone}},
+		},
+	}).Run()
+	require.NoError(t, err)
+	require.True(t, res.Failed)
+	require.Equal(t, map[string]int{"Verified": 10}, res.Groups[0].States)
+}
+
+func TestScenario_Run_invalid(t *testing.T) {
+	_, err := (&Scenario{Groups: []*Group{{Count: 1, State: "Killed"}}}).Run()
+	require.Error(t, err)
+	_, err = (&Scenario{Groups: []*Group{{Count: 1, Solver: &Solver{Type: "lazy"}}}}).Run()
+	require.Error(t, err)
+	_, err = (&Scenario{Groups: []*Group{{Count: 1, Flips: 1, ReportedFlips: 2}}}).Run()
+	require.Error(t, err)
+	_, err = (&Scenario{ConsensusVersion: 1}).Run()
+	require.Error(t, err)
+}
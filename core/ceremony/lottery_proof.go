@@ -0,0 +1,222 @@
+package ceremony
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	"github.com/pkg/errors"
+)
+
+// LotteryProof contains public inputs of the flip lottery of the identity shard along with flips assigned
+// to the identity by the node, VerifyLotteryProof recomputes the assignment from the inputs only
+type LotteryProof struct {
+	Epoch           uint16
+	Address         common.Address
+	ShardId         common.ShardId
+	Seed            []byte
+	ShortFlipsCount int
+	// Identities of the shard in the lottery order, non candidates are kept to make the list auditable
+	Identities []database.DbLotteryIdentity
+	ShortFlips [][]byte
+	LongFlips  [][]byte
+	// Height is the height of the block the lottery started at, identities are taken from its state and the seed is
+	// the seed of the block at SeedHeight. Heights are 0 if the lottery isn't bound to blocks, e.g. in simulations
+	Height     uint64
+	SeedHeight uint64
+	// Header and SeedHeader are headers of blocks at Height and SeedHeight
+	Header     *types.Header
+	SeedHeader *types.Header
+	// IdentityProofs prove Identities against the state root of Header, they are empty if the state of the block is pruned
+	IdentityProofs [][]byte
+}
+
+// LotteryProof returns inputs and results of the flip lottery of the identity, flips of past epochs are taken
+// from saved validation results
+func (vc *ValidationCeremony) LotteryProof(addr common.Address, epoch uint16) (*LotteryProof, error) {
+	epochDb := database.NewEpochDb(vc.db, epoch)
+	seed := epochDb.ReadLotterySeed()
+	identities := epochDb.ReadLotteryIdentities()
+	if len(seed) == 0 || len(identities) == 0 {
+		return nil, errors.Errorf("flip lottery of epoch %v is not found", epoch)
+	}
+	proof := &LotteryProof{
+		Epoch:           epoch,
+		Address:         addr,
+		Seed:            seed,
+		ShortFlipsCount: int(common.ShortSessionFlipsCount() + common.ShortSessionExtraFlipsCount()),
+	}
+	identity := findLotteryIdentity(identities, addr)
+	if identity == nil {
+		return nil, errors.New("identity is not a participant of the flip lottery")
+	}
+	proof.ShardId = identity.ShiftedShardId
+	proof.Identities = shardLotteryIdentities(identities, proof.ShardId)
+	proof.Height, proof.SeedHeight = epochDb.ReadLotteryHeights()
+	if vc.chain != nil && proof.Height > 0 {
+		vc.addLotteryChainData(proof)
+	}
+
+	if epoch == vc.epoch {
+		if !vc.lottery.finished {
+			return nil, errors.New("flip lottery is not finished")
+		}
+		proof.ShortFlips = vc.GetShortFlipsToSolve(addr, proof.ShardId)
+		proof.LongFlips = vc.GetLongFlipsToSolve(addr, proof.ShardId)
+		return proof, nil
+	}
+
+	validationResult, err := vc.ValidationResult(addr, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if validationResult == nil {
+		return nil, errors.Errorf("validation result of epoch %v is not found", epoch)
+	}
+	for _, flip := range validationResult.ShortFlips {
+		proof.ShortFlips = append(proof.ShortFlips, flip.Cid)
+	}
+	for _, flip := range validationResult.LongFlips {
+		proof.LongFlips = append(proof.LongFlips, flip.Cid)
+	}
+	return proof, nil
+}
+
+// addLotteryChainData adds headers of the lottery blocks to the proof and state proofs of identities if the state
+// of the lottery block is still kept
+func (vc *ValidationCeremony) addLotteryChainData(proof *LotteryProof) {
+	proof.Header = vc.chain.GetBlockHeaderByHeight(proof.Height)
+	proof.SeedHeader = vc.chain.GetBlockHeaderByHeight(proof.SeedHeight)
+	identityProofs := make([][]byte, 0, len(proof.Identities))
+	for _, identity := range proof.Identities {
+		identityProof, err := vc.chain.GetIdentityWithProof(proof.Height, identity.Address)
+		if err != nil {
+			return
+		}
+		identityProofs = append(identityProofs, identityProof)
+	}
+	proof.IdentityProofs = identityProofs
+}
+
+// ComputeFlipsToSolve runs the flip lottery of the address shard and returns short and long session flips
+// assigned to the address, identities should be given in the lottery order
+func ComputeFlipsToSolve(addr common.Address, seed []byte, identities []database.DbLotteryIdentity, shortFlipsCount int) (short [][]byte, long [][]byte, err error) {
+	identity := findLotteryIdentity(identities, addr)
+	if identity == nil {
+		return nil, nil, errors.New("identity is not a participant of the flip lottery")
+	}
+	if !state.IsCeremonyCandidateData(state.IdentityState(identity.State), identity.HasDoneAllRequiredFlips) {
+		return nil, nil, errors.New("identity is not a ceremony candidate")
+	}
+	if len(seed) == 0 {
+		return nil, nil, errors.New("lottery seed is empty")
+	}
+	shardId := identity.ShiftedShardId
+	shard := newCandidatesOfShard()
+	for _, item := range shardLotteryIdentities(identities, shardId) {
+		shard.addLotteryIdentity(item)
+	}
+	shardLotteries := GetAuthorsDistribution(map[common.ShardId]*candidatesOfShard{shardId: shard}, seed, shortFlipsCount)
+	shortFlipsPerCandidate, longFlipsPerCandidate := GetFlipsDistribution(len(shard.candidates), shardLotteries[shardId].authorsPerCandidate, shard.flipsPerAuthor, shard.flips, seed, shortFlipsCount)
+	return getFlipsToSolve(addr, shard.candidates, shortFlipsPerCandidate, shard.flips),
+		getFlipsToSolve(addr, shard.candidates, longFlipsPerCandidate, shard.flips), nil
+}
+
+// VerifyLotteryProof checks that flips of the proof match the flip lottery recomputed from its inputs
+func VerifyLotteryProof(proof *LotteryProof) error {
+	short, long, err := ComputeFlipsToSolve(proof.Address, proof.Seed, proof.Identities, proof.ShortFlipsCount)
+	if err != nil {
+		return err
+	}
+	if identity := findLotteryIdentity(proof.Identities, proof.Address); identity.ShiftedShardId != proof.ShardId {
+		return errors.Errorf("identity belongs to shard %v, proof shard is %v", identity.ShiftedShardId, proof.ShardId)
+	}
+	if err := compareFlips("short", short, proof.ShortFlips); err != nil {
+		return err
+	}
+	return compareFlips("long", long, proof.LongFlips)
+}
+
+// VerifyLotteryInputs checks inputs of the proof against the chain: the seed against the header of the seed block and
+// identities against their state proofs at the lottery block. Headers should be checked against block hashes from a source
+// the verifier trusts. Identities missing from the proof aren't detected since it needs the whole identity set of the state.
+func VerifyLotteryInputs(proof *LotteryProof) error {
+	if proof.Header == nil || proof.SeedHeader == nil {
+		return errors.New("headers of the lottery blocks are missing")
+	}
+	if proof.Header.Height() != proof.Height || proof.SeedHeader.Height() != proof.SeedHeight {
+		return errors.New("headers of the lottery blocks don't match proof heights")
+	}
+	if !proof.Header.Flags().HasFlag(types.FlipLotteryStarted) {
+		return errors.Errorf("block %v doesn't start the flip lottery", proof.Height)
+	}
+	if proof.SeedHeight >= proof.Height || proof.Height > LotterySeedLag && proof.SeedHeight < proof.Height-LotterySeedLag {
+		return errors.Errorf("block %v isn't the seed block of the lottery started at block %v", proof.SeedHeight, proof.Height)
+	}
+	if !bytes.Equal(proof.SeedHeader.Seed().Bytes(), proof.Seed) {
+		return errors.Errorf("seed doesn't match the seed of block %v", proof.SeedHeight)
+	}
+	if len(proof.IdentityProofs) != len(proof.Identities) {
+		return errors.Errorf("state proofs of identities are missing, the state of block %v may be pruned", proof.Height)
+	}
+	root := proof.Header.Root()
+	for i, identity := range proof.Identities {
+		value, err := state.VerifyValueWithProof(root, state.StateDbKeys.IdentityKey(identity.Address), proof.IdentityProofs[i])
+		if err != nil {
+			return errors.Wrapf(err, "invalid state proof of identity %v", identity.Address.Hex())
+		}
+		var data state.Identity
+		if err := data.FromBytes(value); err != nil {
+			return errors.Wrapf(err, "invalid state of identity %v", identity.Address.Hex())
+		}
+		if !equalLotteryIdentities(newLotteryIdentity(identity.Address, data), identity) {
+			return errors.Errorf("identity %v doesn't match the state of block %v", identity.Address.Hex(), proof.Height)
+		}
+	}
+	return nil
+}
+
+func equalLotteryIdentities(a, b database.DbLotteryIdentity) bool {
+	if a.Address != b.Address || a.ShiftedShardId != b.ShiftedShardId || a.State != b.State ||
+		a.HasDoneAllRequiredFlips != b.HasDoneAllRequiredFlips || !bytes.Equal(a.PubKey, b.PubKey) || len(a.FlipCids) != len(b.FlipCids) {
+		return false
+	}
+	for i := range a.FlipCids {
+		if !bytes.Equal(a.FlipCids[i], b.FlipCids[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareFlips(session string, expected [][]byte, actual [][]byte) error {
+	if len(expected) != len(actual) {
+		return errors.Errorf("%v session flips count mismatch, expected %v, got %v", session, len(expected), len(actual))
+	}
+	for i := range expected {
+		if !bytes.Equal(expected[i], actual[i]) {
+			return errors.Errorf("%v session flip #%v mismatch, expected %x, got %x", session, i, expected[i], actual[i])
+		}
+	}
+	return nil
+}
+
+func findLotteryIdentity(identities []database.DbLotteryIdentity, addr common.Address) *database.DbLotteryIdentity {
+	for i := range identities {
+		if identities[i].Address == addr {
+			return &identities[i]
+		}
+	}
+	return nil
+}
+
+func shardLotteryIdentities(identities []database.DbLotteryIdentity, shardId common.ShardId) []database.DbLotteryIdentity {
+	var res []database.DbLotteryIdentity
+	for _, identity := range identities {
+		if identity.ShiftedShardId == shardId {
+			res = append(res, identity)
+		}
+	}
+	return res
+}
@@ -0,0 +1,122 @@
+package ceremony
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/tests"
+	"github.com/stretchr/testify/require"
+	db2 "github.com/tendermint/tm-db"
+	"testing"
+)
+
+func TestValidationCeremony_LotteryProof(t *testing.T) {
+	require := require.New(t)
+	appState, _ := appstate.NewAppState(db2.NewMemDB(), eventbus.New())
+	appState.State.SetGlobalEpoch(5)
+
+	var addrs []common.Address
+	for i := 0; i < 20; i++ {
+		addr := tests.GetRandAddr()
+		addrs = append(addrs, addr)
+		appState.State.SetState(addr, state.Verified)
+		appState.State.SetRequiredFlips(addr, 3)
+		for j := 0; j < 3; j++ {
+			cid := crypto.Hash(append(addr.Bytes(), byte(j)))
+			appState.State.AddFlip(addr, cid[:], 0)
+		}
+	}
+	nonCandidate := tests.GetRandAddr()
+	appState.State.SetState(nonCandidate, state.Verified)
+	appState.State.SetRequiredFlips(nonCandidate, 3)
+	require.NoError(appState.Commit(nil, true))
+	require.NoError(appState.Initialize(1))
+
+	simulation, err := NewSimulation(appState, &config.Config{Consensus: config.ConsensusVersions[config.ConsensusV8]}, common.Hash{0x1, 0x2}.Bytes())
+	require.NoError(err)
+	vc := simulation.vc
+
+	addr := addrs[7]
+	short, long := simulation.FlipsToSolve(addr)
+	proof, err := vc.LotteryProof(addr, 5)
+	require.NoError(err)
+	require.Equal(short, proof.ShortFlips)
+	require.Equal(long, proof.LongFlips)
+	require.Len(proof.Identities, 21)
+	require.NoError(VerifyLotteryProof(proof))
+
+	_, err = vc.LotteryProof(nonCandidate, 5)
+	require.NoError(err)
+	_, err = vc.LotteryProof(tests.GetRandAddr(), 5)
+	require.Error(err)
+	_, err = vc.LotteryProof(addr, 4)
+	require.Error(err)
+
+	tampered := *proof
+	tampered.Seed = common.Hash{0x3}.Bytes()
+	require.Error(VerifyLotteryProof(&tampered))
+
+	tampered = *proof
+	tampered.ShortFlips = append([][]byte{proof.ShortFlips[1], proof.ShortFlips[0]}, proof.ShortFlips[2:]...)
+	require.Error(VerifyLotteryProof(&tampered))
+
+	// the first candidate is moved to the end, the non candidate doesn't affect the lottery
+	first := 0
+	if proof.Identities[first].Address == nonCandidate {
+		first++
+	}
+	tampered = *proof
+	tampered.Identities = append(proof.Identities[:first:first], proof.Identities[first+1:]...)
+	tampered.Identities = append(tampered.Identities, proof.Identities[first])
+	require.Error(VerifyLotteryProof(&tampered))
+
+	// inputs are checked against headers and state proofs of the lottery blocks
+	require.Error(VerifyLotteryInputs(proof))
+	bound := *proof
+	bound.Height, bound.SeedHeight = 200, 100
+	bound.Header = &types.Header{ProposedHeader: &types.ProposedHeader{Height: 200, Root: appState.State.Root(), Flags: types.FlipLotteryStarted}}
+	seed := types.Seed{}
+	seed.SetBytes(proof.Seed)
+	bound.SeedHeader = &types.Header{ProposedHeader: &types.ProposedHeader{Height: 100, BlockSeed: seed}}
+	for _, identity := range bound.Identities {
+		identityProof, err := appState.State.GetIdentityWithProof(identity.Address)
+		require.NoError(err)
+		bound.IdentityProofs = append(bound.IdentityProofs, identityProof)
+	}
+	require.NoError(VerifyLotteryInputs(&bound))
+
+	tampered = bound
+	tampered.Seed = common.Hash{0x3}.Bytes()
+	require.Error(VerifyLotteryInputs(&tampered))
+
+	tampered = bound
+	tampered.SeedHeight, tampered.SeedHeader = 99, &types.Header{ProposedHeader: &types.ProposedHeader{Height: 99, BlockSeed: seed}}
+	require.Error(VerifyLotteryInputs(&tampered))
+
+	tampered = bound
+	tampered.Identities = append(bound.Identities[:0:0], bound.Identities...)
+	tampered.Identities[3].FlipCids = tampered.Identities[4].FlipCids
+	require.Error(VerifyLotteryInputs(&tampered))
+
+	tampered = bound
+	tampered.IdentityProofs = bound.IdentityProofs[1:]
+	require.Error(VerifyLotteryInputs(&tampered))
+
+	// flips of past epochs are taken from validation results
+	vc.writeValidationResults(map[common.Address]*IdentityValidationResult{
+		addr: {
+			Epoch:      5,
+			ShortFlips: []*FlipValidationResult{{Cid: short[0]}, {Cid: short[1]}},
+		},
+	})
+	vc.epochDb.Clear()
+	vc.epoch = 6
+	proof, err = vc.LotteryProof(addr, 5)
+	require.NoError(err)
+	require.Equal(short[:2], proof.ShortFlips)
+	require.Error(VerifyLotteryProof(proof))
+}
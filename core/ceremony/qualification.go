@@ -73,6 +73,12 @@ func (q *qualification) removeAnswers(short bool, sender common.Address) {
 	q.hasChanges = true
 }
 
+func (q *qualification) answersCount() (short int, long int) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return len(q.shortAnswers), len(q.longAnswers)
+}
+
 func (q *qualification) persist() {
 	if !q.hasChanges {
 		return
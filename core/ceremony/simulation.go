@@ -0,0 +1,147 @@
+package ceremony
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/ecies"
+	"github.com/idena-network/idena-go/crypto/vrf/p256"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/stats/collector"
+	statsTypes "github.com/idena-network/idena-go/stats/types"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+	"time"
+)
+
+// Simulation runs the flip lottery and the validation of the current epoch of an app state without a node.
+// Answers and evidence maps are submitted directly instead of transactions, the new epoch is applied by the same
+// code the node uses so results match the ones of a real ceremony with the same identities, flips, seed and answers.
+type Simulation struct {
+	vc       *ValidationCeremony
+	rnd      uint64
+	proof    []byte
+	proofKey *ecies.PrivateKey
+}
+
+type simulationSyncer struct{}
+
+func (simulationSyncer) IsSyncing() bool {
+	return false
+}
+
+// NewSimulation runs the flip lottery for ceremony candidates of the app state
+func NewSimulation(appState *appstate.AppState, conf *config.Config, seed []byte) (*Simulation, error) {
+	if len(seed) < 8 {
+		return nil, errors.New("seed should be at least 8 bytes long")
+	}
+	db := dbm.NewMemDB()
+	epoch := appState.State.Epoch()
+	epochDb := database.NewEpochDb(db, epoch)
+	vc := &ValidationCeremony{
+		appState:           appState,
+		db:                 db,
+		log:                log.New(),
+		epochDb:            epochDb,
+		epoch:              epoch,
+		config:             conf,
+		epochApplyingCache: make(map[uint64]epochApplyingCache),
+		qualification:      NewQualification(conf, epochDb),
+		lottery:            &lottery{},
+		syncer:             simulationSyncer{},
+	}
+	epochDb.WriteLotterySeed(seed)
+	vc.shardCandidates = vc.getCandidatesAndFlips(false)
+	vc.candidateIndexes = make(map[common.Address]int)
+	for _, shard := range vc.shardCandidates {
+		for index, c := range shard.candidates {
+			vc.candidateIndexes[c.Address] = index
+		}
+	}
+	vc.distributeFlips(seed)
+
+	// all candidates share the proof of flip words, it is checked against short answers only
+	vrfKey, _ := p256.GenerateKey()
+	hash, proof := vrfKey.Evaluate(seed)
+	proofKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Simulation{
+		vc:       vc,
+		rnd:      getWordsRnd(hash),
+		proof:    proof,
+		proofKey: ecies.ImportECDSA(proofKey),
+	}, nil
+}
+
+// Candidates returns ceremony candidates of the shard in the order used by evidence maps
+func (s *Simulation) Candidates(shardId common.ShardId) []common.Address {
+	if _, ok := s.vc.shardCandidates[shardId]; !ok {
+		return nil
+	}
+	return s.vc.getCandidatesAddresses(shardId)
+}
+
+// FlipsToSolve returns cids of short and long session flips of the candidate
+func (s *Simulation) FlipsToSolve(addr common.Address) (short [][]byte, long [][]byte) {
+	identity := s.vc.appState.State.GetIdentity(addr)
+	shardId := identity.ShiftedShardId()
+	return s.vc.GetShortFlipsToSolve(addr, shardId), s.vc.GetLongFlipsToSolve(addr, shardId)
+}
+
+// SubmitAnswers saves answers of the candidate as if answers hash, short and long answers transactions were mined,
+// nil answers are not submitted
+func (s *Simulation) SubmitAnswers(addr common.Address, short *types.Answers, long *types.Answers) {
+	salt := addr.Bytes()
+	if short != nil {
+		s.vc.epochDb.WriteAnswerHash(addr, crypto.Hash(append(short.Bytes(), salt...)), time.Now())
+		s.vc.appState.State.SetValidationTxBit(addr, types.SubmitAnswersHashTx)
+		s.vc.qualification.addAnswers(true, addr, attachments.CreateShortAnswerAttachment(short.Bytes(), s.rnd, ClientTypeDesktop))
+		s.vc.appState.State.SetValidationTxBit(addr, types.SubmitShortAnswersTx)
+	}
+	if long != nil {
+		s.vc.qualification.addAnswers(false, addr, attachments.CreateLongAnswerAttachment(long.Bytes(), s.proof, salt, s.proofKey))
+		s.vc.appState.State.SetValidationTxBit(addr, types.SubmitLongAnswersTx)
+	}
+}
+
+// SubmitEvidence saves the evidence map of the candidate approving the given candidates of its shard
+func (s *Simulation) SubmitEvidence(addr common.Address, approved []common.Address) {
+	identity := s.vc.appState.State.GetIdentity(addr)
+	candidates := s.Candidates(identity.ShiftedShardId())
+	approvedSet := make(map[common.Address]struct{}, len(approved))
+	for _, item := range approved {
+		approvedSet[item] = struct{}{}
+	}
+	bitmap := common.NewBitmap(uint32(len(candidates)))
+	for i, candidate := range candidates {
+		if _, ok := approvedSet[candidate]; ok {
+			bitmap.Add(uint32(i))
+		}
+	}
+	buf := new(bytes.Buffer)
+	bitmap.WriteTo(buf)
+	s.vc.epochDb.WriteEvidenceMap(addr, buf.Bytes())
+	s.vc.appState.State.SetValidationTxBit(addr, types.EvidenceTx)
+}
+
+// ApplyNewEpoch qualifies flips and candidates and applies new identity states to the app state
+func (s *Simulation) ApplyNewEpoch(height uint64, statsCollector collector.StatsCollector) types.TotalValidationResult {
+	return s.vc.ApplyNewEpoch(height, s.vc.appState, statsCollector)
+}
+
+// ValidationStats returns flip qualifications and answers of the applied epoch
+func (s *Simulation) ValidationStats() *statsTypes.ValidationStats {
+	return s.vc.validationStats
+}
+
+// ValidationResult returns the validation breakdown of the identity of the applied epoch
+func (s *Simulation) ValidationResult(addr common.Address) (*IdentityValidationResult, error) {
+	return s.vc.ValidationResult(addr, s.vc.epoch)
+}
@@ -3,6 +3,7 @@ package ceremony
 import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
 )
 
 type candidate struct {
@@ -40,3 +41,28 @@ type FlipQualification struct {
 	answer types.Answer
 	grade  types.Grade
 }
+
+// CeremonyStatus is a snapshot of the validation ceremony progress of the current epoch
+type CeremonyStatus struct {
+	Epoch  uint16
+	Period state.ValidationPeriod
+	// Phase is the flag of the last started ceremony phase, 0 before the flip lottery
+	Phase types.BlockFlag
+
+	PublicFlipKeys          int
+	PrivateFlipKeysPackages int
+	AnswerHashes            int
+	ShortAnswers            int
+	LongAnswers             int
+	EvidenceMaps            int
+
+	// Readiness of the node coinbase, flips are counted only if the coinbase is a ceremony candidate
+	ValidationReady  bool
+	Candidate        bool
+	ShortFlips       int
+	ShortFlipsLoaded int
+	ShortFlipsReady  int
+	LongFlips        int
+	LongFlipsLoaded  int
+	LongFlipsReady   int
+}
@@ -0,0 +1,156 @@
+package ceremony
+
+import (
+	"encoding/json"
+	"fmt"
+	mapset "github.com/deckarep/golang-set"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	statsTypes "github.com/idena-network/idena-go/stats/types"
+	"math"
+)
+
+// ValidationRule is the rule of determineNewIdentityState which decided the new identity state
+type ValidationRule string
+
+const (
+	RuleRequiredFlipsNotSubmitted ValidationRule = "requiredFlipsNotSubmitted"
+	RuleNotValidating             ValidationRule = "notValidating"
+	RuleInviteNotActivated        ValidationRule = "inviteNotActivated"
+	RuleMissed                    ValidationRule = "missed"
+	RuleNoQualifiedShortFlips     ValidationRule = "noQualifiedShortFlips"
+	RuleNoQualifiedLongFlips      ValidationRule = "noQualifiedLongFlips"
+	RuleHumanCriteria             ValidationRule = "humanCriteria"
+	RuleVerifiedCriteria          ValidationRule = "verifiedCriteria"
+	RuleNewbieCriteria            ValidationRule = "newbieCriteria"
+	RuleHumanDowngrade            ValidationRule = "humanDowngrade"
+	RuleCriteriaNotMet            ValidationRule = "criteriaNotMet"
+)
+
+var ValidationRuleDescriptions = map[ValidationRule]string{
+	RuleRequiredFlipsNotSubmitted: "Required flips were not submitted: verified and human identities become suspended, others are killed",
+	RuleNotValidating:             "Identity was not a validation participant, the state is kept",
+	RuleInviteNotActivated:        "Invite was not activated before the validation",
+	RuleMissed: "Validation was missed (answers were not submitted or the identity was not approved by evidence of other participants): " +
+		"verified and human identities become suspended, suspended become zombie, others are killed",
+	RuleNoQualifiedShortFlips: "None of short session flips were qualified, the state is kept",
+	RuleNoQualifiedLongFlips: fmt.Sprintf("None of long session flips were qualified, the state is kept if short score >= %v and total score is enough "+
+		"for the state, otherwise human identities become suspended", common.MinShortScore),
+	RuleHumanCriteria: fmt.Sprintf("Human criteria are met: total score >= %v, short score >= %v, long score >= %v and at least %v qualified flips in total "+
+		"(not required for human identities)", common.MinHumanTotalScore, common.MinShortScore, common.MinLongScore, common.MinFlipsForHuman),
+	RuleVerifiedCriteria: fmt.Sprintf("Verified criteria are met: total score >= %v, short score >= %v, long score >= %v "+
+		"(not required for zombie identities) and at least %v qualified flips in total for newbies and verified identities",
+		common.MinTotalScore, common.MinShortScore, common.MinLongScore, common.MinFlipsForVerified),
+	RuleNewbieCriteria: fmt.Sprintf("Newbie criteria are met: short score >= %v, long score >= %v and less than %v qualified flips in total",
+		common.MinShortScore, common.MinLongScore, common.MinFlipsForVerified),
+	RuleHumanDowngrade: fmt.Sprintf("Human criteria are not met, scores are below verified criteria: total score >= %v, short score >= %v, long score >= %v",
+		common.MinTotalScore, common.MinShortScore, common.MinLongScore),
+	RuleCriteriaNotMet: "Scores do not meet criteria of any state",
+}
+
+// FlipValidationResult is the qualification of a flip solved by the identity along with the identity answer
+type FlipValidationResult struct {
+	Cid []byte
+	// Status, Answer and Grade are the flip qualification by all respondents
+	Status FlipStatus
+	Answer types.Answer
+	Grade  types.Grade
+	// NotApproved flips of the short session are replaced by extra flips
+	NotApproved      bool
+	RespondentAnswer types.Answer
+	RespondentGrade  types.Grade
+	Point            float32
+	Considered       bool
+}
+
+// IdentityValidationResult is the breakdown of the identity validation used to explain the new identity state
+type IdentityValidationResult struct {
+	Epoch                   uint16
+	ShardId                 common.ShardId
+	PrevState               state.IdentityState
+	NewState                state.IdentityState
+	Rule                    ValidationRule
+	HasDoneAllRequiredFlips bool
+	Candidate               bool
+	Approved                bool
+	Missed                  bool
+	NoAnswersShort          bool
+	NoAnswersLong           bool
+	NoQualifiedShort        bool
+	NoQualifiedLong         bool
+	ShortPoint              float32
+	ShortQualifiedFlips     uint32
+	ShortScore              float32
+	LongPoint               float32
+	LongQualifiedFlips      uint32
+	LongScore               float32
+	PrevTotalPoints         float32
+	PrevTotalQualifiedFlips uint32
+	TotalScore              float32
+	TotalQualifiedFlips     uint32
+	ShortFlips              []*FlipValidationResult
+	LongFlips               []*FlipValidationResult
+	BadAuthor               *types.BadAuthorReason
+	AuthorResults           *types.AuthorResults
+}
+
+func newFlipValidationResults(flips [][]byte, flipsToSolve []int, flipQualificationMap map[int]FlipQualification,
+	flipAnswers map[int]statsTypes.FlipAnswerStats, notApprovedFlips mapset.Set) []*FlipValidationResult {
+	res := make([]*FlipValidationResult, 0, len(flipsToSolve))
+	for _, flipIdx := range flipsToSolve {
+		qual := flipQualificationMap[flipIdx]
+		flipResult := &FlipValidationResult{
+			Status:      qual.status,
+			Answer:      qual.answer,
+			Grade:       qual.grade,
+			NotApproved: notApprovedFlips != nil && notApprovedFlips.Contains(flipIdx),
+		}
+		if flipIdx < len(flips) {
+			flipResult.Cid = flips[flipIdx]
+		}
+		if answer, ok := flipAnswers[flipIdx]; ok {
+			flipResult.RespondentAnswer = answer.Answer
+			flipResult.RespondentGrade = answer.Grade
+			flipResult.Point = answer.Point
+			flipResult.Considered = answer.Considered
+		}
+		res = append(res, flipResult)
+	}
+	return res
+}
+
+// finiteScore replaces undefined or infinite total scores of identities without qualified flips which can't be serialized
+func finiteScore(score float32) float32 {
+	if math.IsNaN(float64(score)) || math.IsInf(float64(score), 0) {
+		return 0
+	}
+	return score
+}
+
+func (vc *ValidationCeremony) writeValidationResults(results map[common.Address]*IdentityValidationResult) {
+	data := make(map[common.Address][]byte, len(results))
+	for addr, result := range results {
+		bytes, err := json.Marshal(result)
+		if err != nil {
+			vc.log.Warn("Failed to serialize validation result", "addr", addr, "err", err)
+			continue
+		}
+		data[addr] = bytes
+	}
+	vc.epochDb.WriteValidationResults(data)
+}
+
+// ValidationResult returns the validation breakdown of the identity saved at the end of the epoch
+func (vc *ValidationCeremony) ValidationResult(addr common.Address, epoch uint16) (*IdentityValidationResult, error) {
+	data := database.NewEpochDb(vc.db, epoch).ReadValidationResult(addr)
+	if data == nil {
+		return nil, nil
+	}
+	res := new(IdentityValidationResult)
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package ceremony
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/tests"
+	"github.com/stretchr/testify/require"
+	db2 "github.com/tendermint/tm-db"
+	"math"
+	"testing"
+)
+
+func TestValidationCeremony_writeValidationResults(t *testing.T) {
+	db := db2.NewMemDB()
+	vc := &ValidationCeremony{
+		db:      db,
+		epochDb: database.NewEpochDb(db, 3),
+		log:     log.New(),
+	}
+	addr := tests.GetRandAddr()
+	missedAddr := tests.GetRandAddr()
+	totalScore, _ := calculateNewTotalScore(nil, 0, 0, 0, 0)
+
+	vc.writeValidationResults(map[common.Address]*IdentityValidationResult{
+		addr: {
+			Epoch:      3,
+			PrevState:  state.Verified,
+			NewState:   state.Human,
+			Rule:       RuleHumanCriteria,
+			TotalScore: 0.95,
+		},
+		missedAddr: {
+			Epoch:      3,
+			PrevState:  state.Candidate,
+			NewState:   state.Killed,
+			Rule:       RuleMissed,
+			Missed:     true,
+			TotalScore: finiteScore(totalScore),
+		},
+	})
+
+	res, err := vc.ValidationResult(addr, 3)
+	require.NoError(t, err)
+	require.Equal(t, state.Human, res.NewState)
+	require.Equal(t, RuleHumanCriteria, res.Rule)
+	require.Equal(t, float32(0.95), res.TotalScore)
+
+	res, err = vc.ValidationResult(missedAddr, 3)
+	require.NoError(t, err)
+	require.Equal(t, RuleMissed, res.Rule)
+	require.Zero(t, res.TotalScore)
+
+	res, err = vc.ValidationResult(addr, 4)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func Test_finiteScore(t *testing.T) {
+	require.Equal(t, float32(0.5), finiteScore(0.5))
+	require.Zero(t, finiteScore(float32(math.NaN())))
+	require.Zero(t, finiteScore(float32(math.Inf(1))))
+	require.Zero(t, finiteScore(float32(math.Inf(-1))))
+}
@@ -399,6 +399,20 @@ func (p *KeysPool) GetEncryptedPrivateFlipKey(indexInPackage int, address common
 	return keysArray.Pairs[indexInPackage]
 }
 
+// PublicFlipKeysCount returns the number of public flip keys published in the current epoch
+func (p *KeysPool) PublicFlipKeysCount() int {
+	p.publicKeyMutex.RLock()
+	defer p.publicKeyMutex.RUnlock()
+	return len(p.flipKeys)
+}
+
+// PrivateFlipKeysPackagesCount returns the number of private flip keys packages published in the current epoch
+func (p *KeysPool) PrivateFlipKeysPackagesCount() int {
+	p.privateKeysMutex.RLock()
+	defer p.privateKeysMutex.RUnlock()
+	return len(p.flipKeyPackages)
+}
+
 func (p *KeysPool) Clear() {
 	p.privateKeysMutex.Lock()
 	p.publicKeyMutex.Lock()
@@ -16,6 +16,7 @@ import (
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/pkg/errors"
+	"math/big"
 	"sort"
 	"sync"
 	"time"
@@ -29,6 +30,7 @@ const (
 var (
 	DuplicateTxError = errors.New("tx with same hash already exists")
 	MempoolFullError = errors.New("mempool is full")
+	UnderpricedError = errors.New("replacement tx underpriced")
 	priorityTypes    = validation.CeremonialTxs
 )
 
@@ -178,7 +180,14 @@ func (pool *TxPool) Validate(tx *types.Transaction) error {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
 
-	if err := pool.checkLimits(tx); err != nil {
+	sender, _ := types.Sender(tx)
+	replaced := pool.findSameNonceTx(sender, tx)
+	if replaced != nil {
+		if err := pool.checkReplacement(replaced, tx); err != nil {
+			return err
+		}
+	}
+	if err := pool.checkLimits(tx, replaced); err != nil {
 		return err
 	}
 	appState, err := pool.appState.Readonly(pool.head.Height())
@@ -189,25 +198,26 @@ func (pool *TxPool) Validate(tx *types.Transaction) error {
 	return pool.validate(tx, appState, validation.InboundTx)
 }
 
-func (pool *TxPool) checkLimits(tx *types.Transaction) error {
+// checkLimits checks the pool limits for the tx, the replaced tx with the same nonce (if any) isn't counted
+func (pool *TxPool) checkLimits(tx *types.Transaction, replaced *types.Transaction) error {
 	if priorityTypes[tx.Type] {
-		return pool.checkPriorityTxLimits(tx)
+		return pool.checkPriorityTxLimits(tx, replaced)
 	}
-	return pool.checkRegularTxLimits(tx)
+	return pool.checkRegularTxLimits(tx, replaced)
 }
 
-func (pool *TxPool) checkPriorityTxLimits(tx *types.Transaction) error {
+func (pool *TxPool) checkPriorityTxLimits(tx *types.Transaction, replaced *types.Transaction) error {
 	sender, _ := types.Sender(tx)
 	if executable, ok := pool.executableTxs[sender]; ok {
 		for _, existingTx := range executable.txs {
-			if existingTx.Type == tx.Type {
+			if existingTx.Type == tx.Type && existingTx != replaced {
 				return errors.Errorf("multiple ceremony transaction [type=%v] in executable queue", tx.Type)
 			}
 		}
 	}
 	if txs, ok := pool.pendingTxs[sender]; ok {
 		for _, existingTx := range txs.txs {
-			if existingTx.Type == tx.Type {
+			if existingTx.Type == tx.Type && existingTx != replaced {
 				return errors.Errorf("multiple ceremony transaction [type=%v] in pending queue", tx.Type)
 			}
 		}
@@ -215,7 +225,7 @@ func (pool *TxPool) checkPriorityTxLimits(tx *types.Transaction) error {
 	return nil
 }
 
-func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
+func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction, replaced *types.Transaction) error {
 	var totalLimit = 0
 	if pool.mempoolCfg.TxPoolExecutableSlots < 0 || pool.mempoolCfg.TxPoolQueueSlots < 0 {
 		totalLimit = -1
@@ -223,9 +233,17 @@ func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
 		totalLimit = pool.mempoolCfg.TxPoolExecutableSlots*pool.mempoolCfg.TxPoolAddrExecutableLimit +
 			pool.mempoolCfg.TxPoolQueueSlots*pool.mempoolCfg.TxPoolAddrQueueLimit
 	}
-	if totalLimit > 0 && len(pool.all.txs) >= totalLimit {
+	total := len(pool.all.txs)
+	if replaced != nil {
+		total--
+	}
+	if totalLimit > 0 && total >= totalLimit {
 		return errors.New("tx queue max size reached")
 	}
+	if replaced != nil {
+		// the tx takes the place of the replaced one in the sender queue
+		return nil
+	}
 	sender, _ := types.Sender(tx)
 
 	if byAddr, ok := pool.executableTxs[sender]; ok {
@@ -347,14 +365,21 @@ func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState, own
 	pool.mutex.Lock()
 	locked = true
 
-	if err := pool.checkLimits(tx); err != nil {
+	sender, _ := types.Sender(tx)
+
+	replaced := pool.findSameNonceTx(sender, tx)
+	if replaced != nil {
+		if err := pool.checkReplacement(replaced, tx); err != nil {
+			unlock()
+			return err
+		}
+	}
+	if err := pool.checkLimits(tx, replaced); err != nil {
 		unlock()
 		log.Warn("Tx limits", "hash", tx.Hash().Hex(), "err", err)
 		return err
 	}
 
-	sender, _ := types.Sender(tx)
-
 	if err := pool.validate(tx, appState, txType); err != nil {
 		unlock()
 		if sender == pool.coinbase {
@@ -363,8 +388,9 @@ func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState, own
 		return err
 	}
 
-	err = pool.put(tx)
-	if err != nil {
+	if replaced != nil {
+		pool.replace(sender, replaced, tx)
+	} else if err = pool.put(tx); err != nil {
 		unlock()
 		return err
 	}
@@ -443,6 +469,78 @@ func (pool *TxPool) put(tx *types.Transaction) error {
 	return nil
 }
 
+// findSameNonceTx returns a pool tx of the sender having the same epoch and nonce as the given one
+func (pool *TxPool) findSameNonceTx(sender common.Address, tx *types.Transaction) *types.Transaction {
+	sameNonce := func(existingTx *types.Transaction) bool {
+		return existingTx.Epoch == tx.Epoch && existingTx.AccountNonce == tx.AccountNonce
+	}
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for _, existingTx := range executable.txs {
+			if sameNonce(existingTx) {
+				return existingTx
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		for _, existingTx := range pending.txs {
+			if sameNonce(existingTx) {
+				return existingTx
+			}
+		}
+	}
+	return nil
+}
+
+// MinReplacementPrice returns min MaxFee+Tips of a tx which can replace the given one
+func (pool *TxPool) MinReplacementPrice(replaced *types.Transaction) *big.Int {
+	oldPrice := new(big.Int).Add(replaced.MaxFeeOrZero(), replaced.TipsOrZero())
+	minPrice := new(big.Int).Mul(oldPrice, big.NewInt(int64(100+pool.mempoolCfg.TxReplacementBump)))
+	minPrice.Div(minPrice, big.NewInt(100))
+	if minPrice.Cmp(oldPrice) <= 0 {
+		minPrice.Add(oldPrice, common.Big1)
+	}
+	return minPrice
+}
+
+// checkReplacement checks that MaxFee+Tips of the new tx exceeds the replaced one by the configured bump percentage
+func (pool *TxPool) checkReplacement(replaced *types.Transaction, tx *types.Transaction) error {
+	minPrice := pool.MinReplacementPrice(replaced)
+	if new(big.Int).Add(tx.MaxFeeOrZero(), tx.TipsOrZero()).Cmp(minPrice) < 0 {
+		return errors.Wrapf(UnderpricedError, "maxFee+tips should be at least %v", minPrice)
+	}
+	return nil
+}
+
+// replace puts the new tx to the place of the replaced tx with the same nonce, should be called under the pool mutex
+func (pool *TxPool) replace(sender common.Address, replaced *types.Transaction, tx *types.Transaction) {
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for i, existingTx := range executable.txs {
+			if existingTx == replaced {
+				executable.txs[i] = tx
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		if _, ok := pending.Get(replaced.Hash()); ok {
+			pending.Remove(replaced.Hash())
+			pending.Add(tx)
+		}
+	}
+
+	pool.all.Remove(replaced.Hash())
+	pool.shortHashAll.Remove(replaced.Hash128())
+	delete(pool.txSyncCounts, replaced.Hash())
+	pool.all.Add(tx)
+	pool.shortHashAll.Add(tx)
+
+	if pool.txKeeper != nil {
+		pool.txKeeper.RemoveTxs([]common.Hash{replaced.Hash()})
+	}
+	pool.statsCollector.RemoveMemPoolTx(replaced)
+
+	pool.log.Info("Tx replaced", "hash", replaced.Hash().Hex(), "new", tx.Hash().Hex())
+}
+
 func (pool *TxPool) GetPriorityTransaction() []*types.Transaction {
 	all := pool.all.List(Priority)
 	var result []*types.Transaction
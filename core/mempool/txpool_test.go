@@ -13,6 +13,7 @@ import (
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
 	"math/big"
@@ -447,3 +448,83 @@ func TestTxPool_RecoverValidationTxs_OnAfterLongSession(t *testing.T) {
 	require.Len(t, pool.all.txs, 1)
 	require.NoError(t, pool.AddExternalTxs(validation.InBlockTx, getTx(key2)))
 }
+
+func TestTxPool_ReplaceTx(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil, true)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	// maxFee is in tenths of coin
+	getTx := func(nonce uint32, maxFee int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			MaxFee:       new(big.Int).Div(new(big.Int).Mul(big.NewInt(maxFee), common.DnaBase), big.NewInt(10)),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	tx1, tx2 := getTx(1, 100), getTx(2, 100)
+	require.NoError(t, pool.AddInternalTx(tx1))
+	require.NoError(t, pool.AddInternalTx(tx2))
+
+	require.Equal(t, DuplicateTxError, pool.AddInternalTx(getTx(1, 100)))
+	require.Equal(t, UnderpricedError, errors.Cause(pool.AddInternalTx(getTx(1, 105))))
+	require.Equal(t, UnderpricedError, errors.Cause(pool.AddExternalTxs(validation.InboundTx, getTx(2, 90))))
+
+	replacement := getTx(1, 110)
+	require.NoError(t, pool.AddInternalTx(replacement))
+	require.Nil(t, pool.GetTx(tx1.Hash()))
+	require.Equal(t, replacement, pool.GetTx(replacement.Hash()))
+	require.Len(t, pool.all.txs, 2)
+	require.Equal(t, []*types.Transaction{replacement, tx2}, pool.executableTxs[address].txs)
+
+	pendingTx, pendingReplacement := getTx(4, 100), getTx(4, 200)
+	require.NoError(t, pool.AddInternalTx(pendingTx))
+	require.NoError(t, pool.AddInternalTx(pendingReplacement))
+	require.Len(t, pool.pendingTxs[address].txs, 1)
+	_, ok := pool.pendingTxs[address].Get(pendingReplacement.Hash())
+	require.True(t, ok)
+	require.Len(t, pool.all.txs, 3)
+}
+
+func TestTxPool_ReplaceTx_priorityLimits(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	pool.appState.Initialize(0)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+	getTx := func(nonce uint32, txType types.TxType, maxFee int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			Type:         txType,
+			MaxFee:       big.NewInt(maxFee),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	answersHashTx, sendTx := getTx(1, types.SubmitAnswersHashTx, 100), getTx(2, types.SendTx, 100)
+	require.NoError(t, pool.put(answersHashTx))
+	require.NoError(t, pool.put(sendTx))
+
+	require.Error(t, pool.checkLimits(getTx(2, types.SubmitAnswersHashTx, 200), sendTx))
+	require.NoError(t, pool.checkLimits(getTx(2, types.EvidenceTx, 200), sendTx))
+	require.NoError(t, pool.checkLimits(getTx(1, types.SubmitAnswersHashTx, 200), answersHashTx))
+
+	require.Error(t, pool.Validate(getTx(2, types.SubmitAnswersHashTx, 200)))
+}
@@ -303,6 +303,10 @@ func (s *IdentityStateDB) deleteStateIdentityObject(stateObject *stateApprovedId
 	s.tree.Remove(StateDbKeys.IdentityKey(addr))
 }
 
+func (s *IdentityStateDB) GetIdentityWithProof(addr common.Address) ([]byte, error) {
+	return s.tree.GetImmutable().GetWithProof(StateDbKeys.IdentityKey(addr))
+}
+
 func (s *IdentityStateDB) Root() common.Hash {
 	return s.tree.WorkingHash()
 }
@@ -125,3 +125,13 @@ func (s *identityStateDbPrefix) SaveDbPrefix(batch dbm.Batch, prefix []byte, pre
 	}
 	batch.Set(key, prefix)
 }
+
+// AddressFromKey returns the address of account and identity keys
+func (s *stateDbKeys) AddressFromKey(key []byte) (common.Address, bool) {
+	if len(key) != 1+common.AddressLength || key[0] != addressPrefix[0] && key[0] != identityPrefix[0] {
+		return common.Address{}, false
+	}
+	var addr common.Address
+	addr.SetBytes(key[1:])
+	return addr, true
+}
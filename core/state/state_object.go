@@ -7,6 +7,7 @@ import (
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/math"
 	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/pkg/errors"
 	math2 "math"
 	"math/big"
 	"sort"
@@ -47,6 +48,36 @@ func (f ApprovedIdentityFlag) HasFlag(flag ApprovedIdentityFlag) bool {
 	return f&flag != 0
 }
 
+var identityStateNames = map[IdentityState]string{
+	Undefined: "Undefined",
+	Invite:    "Invite",
+	Candidate: "Candidate",
+	Verified:  "Verified",
+	Suspended: "Suspended",
+	Killed:    "Killed",
+	Zombie:    "Zombie",
+	Newbie:    "Newbie",
+	Human:     "Human",
+}
+
+// String returns the name of the state used by the api, unknown states are named "Undefined"
+func (s IdentityState) String() string {
+	if name, ok := identityStateNames[s]; ok {
+		return name
+	}
+	return identityStateNames[Undefined]
+}
+
+// ParseIdentityState returns the state by its name
+func ParseIdentityState(name string) (IdentityState, error) {
+	for s, n := range identityStateNames {
+		if n == name {
+			return s, nil
+		}
+	}
+	return Undefined, errors.Errorf("unknown identity state %v", name)
+}
+
 func (s IdentityState) IsInShard() bool {
 	return s.NewbieOrBetter() || s == Candidate || s == Suspended || s == Zombie
 }
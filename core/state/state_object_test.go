@@ -34,3 +34,16 @@ func TestApprovedIdentity_migrateToUpgrade8(t *testing.T) {
 	require.True(t, v.Online)
 	require.True(t, v.Discriminated)
 }
+
+func TestIdentityState_String(t *testing.T) {
+	for s := Undefined; s <= Human; s++ {
+		parsed, err := ParseIdentityState(s.String())
+		require.NoError(t, err)
+		require.Equal(t, s, parsed)
+	}
+	require.Equal(t, "Newbie", Newbie.String())
+	require.Equal(t, "Undefined", IdentityState(100).String())
+
+	_, err := ParseIdentityState("newbie")
+	require.Error(t, err)
+}
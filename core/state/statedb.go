@@ -1221,6 +1221,14 @@ func (s *StateDB) GetIdentityWithProof(addr common.Address) ([]byte, error) {
 	return s.tree.GetImmutable().GetWithProof(StateDbKeys.IdentityKey(addr))
 }
 
+func (s *StateDB) GetAccountWithProof(addr common.Address) ([]byte, error) {
+	return s.tree.GetImmutable().GetWithProof(StateDbKeys.AddressKey(addr))
+}
+
+func (s *StateDB) GetContractValueWithProof(addr common.Address, key []byte) ([]byte, error) {
+	return s.tree.GetImmutable().GetWithProof(StateDbKeys.ContractStoreKey(addr, key))
+}
+
 func (s *StateDB) IterateOverIdentities(callback func(addr common.Address, identity Identity)) {
 	s.IterateIdentities(func(key []byte, value []byte) bool {
 		if key == nil {
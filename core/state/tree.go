@@ -5,6 +5,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/common"
 	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/pkg/errors"
 	dbm "github.com/tendermint/tm-db"
 	"sync"
 )
@@ -320,3 +321,53 @@ func (t *ImmutableTree) GetWithProof(key []byte) ([]byte, error) {
 		Proof: proof.LeftPath,
 	}).toBytes()
 }
+
+func (v *valueWithProof) fromBytes(data []byte) error {
+	protoObj := new(models.ValueWithProof)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	if protoObj.Leaf == nil {
+		return errors.New("proof leaf is missing")
+	}
+	v.Value = protoObj.Value
+	v.Leaf = iavl.ProofLeafNode{
+		Key:       protoObj.Leaf.Key,
+		ValueHash: protoObj.Leaf.ValueHash,
+		Version:   int64(protoObj.Leaf.Version),
+	}
+	v.Proof = nil
+	if len(protoObj.Proof) > 0 {
+		v.Proof = make(iavl.PathToLeaf, 0, len(protoObj.Proof))
+		for _, item := range protoObj.Proof {
+			v.Proof = append(v.Proof, iavl.ProofInnerNode{
+				Height:  int8(item.Height),
+				Size:    int64(item.Size),
+				Version: int64(item.Version),
+				Left:    item.Left,
+				Right:   item.Right,
+			})
+		}
+	}
+	return nil
+}
+
+// VerifyValueWithProof checks that the value with proof produced by GetWithProof belongs to the tree with the given root
+// and is stored under the given key, the proven value is returned on success
+func VerifyValueWithProof(root common.Hash, key []byte, data []byte) ([]byte, error) {
+	v := new(valueWithProof)
+	if err := v.fromBytes(data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode proof")
+	}
+	proof := &iavl.RangeProof{
+		LeftPath: v.Proof,
+		Leaves:   []iavl.ProofLeafNode{v.Leaf},
+	}
+	if err := proof.Verify(root.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := proof.VerifyItem(key, v.Value); err != nil {
+		return nil, err
+	}
+	return v.Value, nil
+}
@@ -106,3 +106,35 @@ func TestMutableTree_LoadVersion(t *testing.T) {
 
 	require.Equal(t, hash, tree.WorkingHash())
 }
+
+func TestVerifyValueWithProof(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := NewMutableTree(db)
+	for i := byte(0); i < 100; i++ {
+		tree.Set([]byte{0x1, i}, []byte{i, i})
+	}
+	tree.SaveVersion()
+	root := tree.Hash()
+
+	proof, err := tree.GetImmutable().GetWithProof([]byte{0x1, 0x2a})
+	require.NoError(t, err)
+
+	value, err := VerifyValueWithProof(root, []byte{0x1, 0x2a}, proof)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x2a, 0x2a}, value)
+
+	_, err = VerifyValueWithProof(root, []byte{0x1, 0x2b}, proof)
+	require.Error(t, err)
+
+	_, err = VerifyValueWithProof(common.Hash{0x1}, []byte{0x1, 0x2a}, proof)
+	require.Error(t, err)
+
+	tree.Set([]byte{0x1, 0x2a}, []byte{0x1})
+	tree.SaveVersion()
+	_, err = VerifyValueWithProof(tree.Hash(), []byte{0x1, 0x2a}, proof)
+	require.Error(t, err)
+
+	proof, err = tree.GetImmutable().GetWithProof([]byte{0x2})
+	require.NoError(t, err)
+	require.Nil(t, proof)
+}
@@ -1,6 +1,8 @@
 package database
 
 import (
+	"bytes"
+	"encoding/binary"
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
@@ -12,18 +14,20 @@ import (
 )
 
 var (
-	OwnShortAnswerKey     = []byte("own-short")
-	AnswerHashPrefix      = []byte("hash")
-	ShortAnswersKey       = []byte("answers-short")
-	LongShortAnswersKey   = []byte("answers-long")
-	TxOwnPrefix           = []byte("tx")
-	SuccessfulTxOwnPrefix = []byte("s-tx")
-	EvidencePrefix        = []byte("evi")
-	LotterySeedKey        = []byte("ls")
-	FlipCidPrefix         = []byte("cid")
-	PublicFlipKeyPrefix   = []byte("pubk")
-	PrivateFlipKeyPrefix  = []byte("pk")
-	LotteryIdentities     = []byte("li")
+	OwnShortAnswerKey      = []byte("own-short")
+	AnswerHashPrefix       = []byte("hash")
+	ShortAnswersKey        = []byte("answers-short")
+	LongShortAnswersKey    = []byte("answers-long")
+	TxOwnPrefix            = []byte("tx")
+	SuccessfulTxOwnPrefix  = []byte("s-tx")
+	EvidencePrefix         = []byte("evi")
+	LotterySeedKey         = []byte("ls")
+	LotteryHeightsKey      = []byte("lh")
+	FlipCidPrefix          = []byte("cid")
+	PublicFlipKeyPrefix    = []byte("pubk")
+	PrivateFlipKeyPrefix   = []byte("pk")
+	LotteryIdentities      = []byte("li")
+	ValidationResultPrefix = []byte("vr")
 )
 
 type EpochDb struct {
@@ -73,6 +77,11 @@ func (edb *EpochDb) Clear() {
 	var keys [][]byte
 
 	for ; it.Valid(); it.Next() {
+		// validation results are kept to explain identity states of past epochs,
+		// lottery inputs are kept until ClearLottery to let anyone verify flip distributions of recent epochs
+		if bytes.HasPrefix(it.Key(), ValidationResultPrefix) || isLotteryKey(it.Key()) {
+			continue
+		}
 		keys = append(keys, it.Key())
 	}
 	for _, key := range keys {
@@ -80,6 +89,17 @@ func (edb *EpochDb) Clear() {
 	}
 }
 
+// ClearLottery removes lottery inputs kept by Clear
+func (edb *EpochDb) ClearLottery() {
+	for _, key := range [][]byte{LotterySeedKey, LotteryHeightsKey, LotteryIdentities} {
+		assertNoError(edb.db.Delete(key))
+	}
+}
+
+func isLotteryKey(key []byte) bool {
+	return bytes.Equal(key, LotterySeedKey) || bytes.Equal(key, LotteryHeightsKey) || bytes.Equal(key, LotteryIdentities)
+}
+
 func (edb *EpochDb) WriteAnswerHash(address common.Address, hash common.Hash, timestamp time.Time) {
 	protoAnswer := &models.ProtoShortAnswerDb{
 		Hash:      hash[:],
@@ -111,6 +131,10 @@ func (edb *EpochDb) GetAnswers() map[common.Address]common.Hash {
 	return answers
 }
 
+func (edb *EpochDb) AnswerHashesCount() int {
+	return edb.countKeys(AnswerHashPrefix)
+}
+
 func (edb *EpochDb) GetAnswerHash(address common.Address) common.Hash {
 	key := append(AnswerHashPrefix, address.Bytes()...)
 	data, err := edb.db.Get(key)
@@ -222,6 +246,21 @@ func (edb *EpochDb) ReadEvidenceMaps() []*DbEvidenceMap {
 	return result
 }
 
+func (edb *EpochDb) EvidenceMapsCount() int {
+	return edb.countKeys(EvidencePrefix)
+}
+
+func (edb *EpochDb) countKeys(prefix []byte) int {
+	it, err := edb.db.Iterator(append(prefix, common.MinAddr[:]...), append(prefix, common.MaxAddr[:]...))
+	assertNoError(err)
+	defer it.Close()
+	count := 0
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count
+}
+
 func (edb *EpochDb) WriteEvidenceMap(addr common.Address, bitmap []byte) {
 	edb.db.Set(append(EvidencePrefix, addr[:]...), bitmap)
 }
@@ -269,6 +308,23 @@ func (edb *EpochDb) ReadLotterySeed() []byte {
 	return data
 }
 
+// WriteLotteryHeights saves heights of the block the flip lottery started at and of the block the lottery seed is taken from
+func (edb *EpochDb) WriteLotteryHeights(height uint64, seedHeight uint64) {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data, height)
+	binary.BigEndian.PutUint64(data[8:], seedHeight)
+	assertNoError(edb.db.Set(LotteryHeightsKey, data))
+}
+
+func (edb *EpochDb) ReadLotteryHeights() (height uint64, seedHeight uint64) {
+	data, err := edb.db.Get(LotteryHeightsKey)
+	assertNoError(err)
+	if len(data) != 16 {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint64(data), binary.BigEndian.Uint64(data[8:])
+}
+
 func (edb *EpochDb) WriteFlipCid(cid []byte) {
 	assertNoError(edb.db.Set(append(FlipCidPrefix, cid...), []byte{}))
 }
@@ -424,3 +480,20 @@ func (edb *EpochDb) ReadLotteryIdentities() []DbLotteryIdentity {
 	}
 	return res
 }
+
+func (edb *EpochDb) WriteValidationResults(results map[common.Address][]byte) {
+	batch := edb.db.NewBatch()
+	defer batch.Close()
+	for addr, data := range results {
+		assertNoError(batch.Set(append(ValidationResultPrefix, addr.Bytes()...), data))
+	}
+	if err := batch.Write(); err != nil {
+		log.Warn("Failed to persist validation results", "err", err)
+	}
+}
+
+func (edb *EpochDb) ReadValidationResult(addr common.Address) []byte {
+	data, err := edb.db.Get(append(ValidationResultPrefix, addr.Bytes()...))
+	assertNoError(err)
+	return data
+}
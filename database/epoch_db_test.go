@@ -123,6 +123,27 @@ func TestEpochDb_HasAnswerHash(t *testing.T) {
 	require.True(edb.HasAnswerHash(addr))
 }
 
+func TestEpochDb_Counts(t *testing.T) {
+	require := require.New(t)
+
+	edb := NewEpochDb(db.NewMemDB(), 1)
+	require.Zero(edb.AnswerHashesCount())
+	require.Zero(edb.EvidenceMapsCount())
+
+	for i := 0; i < 3; i++ {
+		addr := tests.GetRandAddr()
+		edb.WriteAnswerHash(addr, common.Hash{0x1}, time.Now())
+		if i > 0 {
+			edb.WriteEvidenceMap(addr, []byte{0x1})
+		}
+	}
+	edb.WriteLotterySeed([]byte{0x2})
+	edb.WriteFlipCid([]byte{0x3})
+
+	require.Equal(3, edb.AnswerHashesCount())
+	require.Equal(2, edb.EvidenceMapsCount())
+}
+
 func TestEpochDb_HasSuccessfulOwnTx(t *testing.T) {
 	require := require.New(t)
 
@@ -135,3 +156,35 @@ func TestEpochDb_HasSuccessfulOwnTx(t *testing.T) {
 	require.True(edb.HasSuccessfulOwnTx(common.Hash{0x1}))
 	require.False(edb.HasSuccessfulOwnTx(common.Hash{0x2}))
 }
+
+func TestEpochDb_ValidationResults(t *testing.T) {
+	require := require.New(t)
+	edb := NewEpochDb(db.NewMemDB(), 1)
+
+	addr := tests.GetRandAddr()
+	edb.WriteValidationResults(map[common.Address][]byte{addr: {0x1}})
+	edb.WriteLotterySeed([]byte{0x2})
+	edb.WriteLotteryHeights(200, 100)
+	edb.WriteLotteryIdentities([]DbLotteryIdentity{{Address: addr, ShiftedShardId: 1, FlipCids: [][]byte{{0x3}}}})
+	edb.WriteAnswerHash(addr, common.Hash{0x4}, time.Now())
+
+	edb.Clear()
+
+	require.Equal([]byte{0x1}, edb.ReadValidationResult(addr))
+	require.Nil(edb.ReadValidationResult(tests.GetRandAddr()))
+	require.Equal([]byte{0x2}, edb.ReadLotterySeed())
+	height, seedHeight := edb.ReadLotteryHeights()
+	require.Equal(uint64(200), height)
+	require.Equal(uint64(100), seedHeight)
+	require.Len(edb.ReadLotteryIdentities(), 1)
+	require.False(edb.HasAnswerHash(addr))
+
+	edb.ClearLottery()
+
+	require.Equal([]byte{0x1}, edb.ReadValidationResult(addr))
+	require.Nil(edb.ReadLotterySeed())
+	height, seedHeight = edb.ReadLotteryHeights()
+	require.Zero(height)
+	require.Zero(seedHeight)
+	require.Empty(edb.ReadLotteryIdentities())
+}
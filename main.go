@@ -33,6 +33,8 @@ func main() {
 		config.TcpPortFlag,
 		config.RpcHostFlag,
 		config.RpcPortFlag,
+		config.WsHostFlag,
+		config.WsPortFlag,
 		config.BootNodeFlag,
 		config.AutomineFlag,
 		config.IpfsBootNodeFlag,
@@ -44,6 +46,10 @@ func main() {
 		config.MaxNetworkDelayFlag,
 		config.FastSyncFlag,
 		config.ForceFullSyncFlag,
+		config.LightModeFlag,
+		config.IndexAllTxsFlag,
+		config.ReindexTxsFlag,
+		config.StatsFlag,
 		config.ProfileFlag,
 		config.IpfsPortStaticFlag,
 		config.ApiKeyFlag,
@@ -106,6 +112,10 @@ func main() {
 		if err != nil {
 			return err
 		}
+		if context.Bool(config.ReindexTxsFlag.Name) {
+			defer n.Close()
+			return n.RebuildTxIndex()
+		}
 		n.Start()
 		n.WaitForStop()
 		return nil
@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"github.com/awnumar/memguard"
 	"github.com/idena-network/idena-go/api"
 	"github.com/idena-network/idena-go/blockchain"
 	"github.com/idena-network/idena-go/blockchain/validation"
@@ -30,6 +31,7 @@ import (
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/idena-network/idena-go/vm"
 	"github.com/pkg/errors"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -58,6 +60,8 @@ type Node struct {
 	httpListener    net.Listener // HTTP RPC listener socket to server API requests
 	httpHandler     *rpc.Server  // HTTP RPC request handler to process the API requests
 	httpServer      *http.Server
+	wsListener      net.Listener // Websocket RPC listener socket to server API requests
+	wsHandler       *rpc.Server  // Websocket RPC request handler to process the API requests
 	log             log.Logger
 	keyStore        *keystore.KeyStore
 	fp              *flip.Flipper
@@ -71,6 +75,8 @@ type Node struct {
 	deferJob        *deferredtx.Job
 	subManager      *subscriptions.Manager
 	upgrader        *upgrade.Upgrader
+	statsStore      collector.StatsStore
+	db              db.DB
 }
 
 type NodeCtx struct {
@@ -134,11 +140,29 @@ func ProvideMobileKey(path string, cfg string, key string, password string) stri
 }
 
 func NewNode(config *config.Config, appVersion string) (*Node, error) {
-	nodeCtx, err := NewNodeWithInjections(config, eventbus.New(), collector.NewStatsCollector(), appVersion)
+	bus := eventbus.New()
+	statsCollector := collector.NewStatsCollector()
+	if config.Stats != nil && config.Stats.Enabled {
+		statsDb, err := OpenDatabase(config.DataDir, "stats", 16, 16)
+		if err != nil {
+			return nil, err
+		}
+		statsCollector = collector.NewDbCollector(statsDb, bus)
+	}
+	nodeCtx, err := NewNodeWithInjections(config, bus, statsCollector, appVersion)
 	if err != nil {
+		if closer, ok := statsCollector.(io.Closer); ok {
+			closer.Close()
+		}
 		return nil, err
 	}
-	return nodeCtx.Node, err
+	node := nodeCtx.Node
+	// replaces the handler of the sec store which exits without closing the stats database
+	memguard.CatchSignal(func(signal os.Signal) {
+		fmt.Println("Interrupt signal received. Exiting...")
+		node.close()
+	}, os.Interrupt)
+	return node, nil
 }
 
 func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollector collector.StatsCollector, appVersion string) (*NodeCtx, error) {
@@ -239,6 +263,10 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 		httpListener:    httpListener,
 		httpHandler:     httpHandler,
 		httpServer:      httpServer,
+		db:              db,
+	}
+	if statsStore, ok := statsCollector.(collector.StatsStore); ok {
+		node.statsStore = statsStore
 	}
 	return &NodeCtx{
 		Node:            node,
@@ -258,6 +286,25 @@ func (node *Node) Start() {
 	node.StartWithHeight(0)
 }
 
+// RebuildTxIndex rebuilds the transaction index from stored blocks without starting the node
+func (node *Node) RebuildTxIndex() error {
+	if privateKey, err := node.config.NodeKey(); err != nil {
+		return err
+	} else {
+		node.secStore.AddKey(crypto.FromECDSA(privateKey))
+	}
+	if err := node.blockchain.InitializeChain(); err != nil {
+		return err
+	}
+	node.log.Info("Start rebuilding transaction index", "head", node.blockchain.Head.Height())
+	if skipped := node.blockchain.RebuildTxIndex(1, node.blockchain.Head.Height()); skipped > 0 {
+		node.log.Warn("Transaction index has been rebuilt, missing blocks have been skipped", "skipped", skipped)
+	} else {
+		node.log.Info("Transaction index has been rebuilt")
+	}
+	return nil
+}
+
 func (node *Node) StartWithHeight(height uint64) {
 	if privateKey, err := node.config.NodeKey(); err != nil {
 		node.log.Crit("Cannot initialize node key", "error", err.Error())
@@ -294,16 +341,21 @@ func (node *Node) StartWithHeight(height uint64) {
 		}
 	}
 
-	node.txpool.Initialize(node.blockchain.Head, node.secStore.GetAddress(), true)
-	node.flipKeyPool.Initialize(node.blockchain.Head)
-	node.votes.Initialize(node.blockchain.Head)
-	node.fp.Initialize()
-	node.ceremony.Initialize(node.blockchain.GetBlock(node.blockchain.Head.Hash()))
-	node.blockchain.ProvideApplyNewEpochFunc(node.ceremony.ApplyNewEpoch)
-	node.offlineDetector.Start(node.blockchain.Head)
+	// light node keeps headers only, so subsystems depending on the state are not started
+	if !node.config.Sync.LightMode {
+		node.txpool.Initialize(node.blockchain.Head, node.secStore.GetAddress(), true)
+		node.flipKeyPool.Initialize(node.blockchain.Head)
+		node.votes.Initialize(node.blockchain.Head)
+		node.fp.Initialize()
+		node.ceremony.Initialize(node.blockchain.GetBlock(node.blockchain.Head.Hash()))
+		node.blockchain.ProvideApplyNewEpochFunc(node.ceremony.ApplyNewEpoch)
+		node.offlineDetector.Start(node.blockchain.Head)
+	}
 	node.consensusEngine.Start()
 	node.pm.Start()
-	node.upgrader.Start()
+	if !node.config.Sync.LightMode {
+		node.upgrader.Start()
+	}
 
 	node.stopInitialRPC()
 	// Configure RPC
@@ -314,7 +366,25 @@ func (node *Node) StartWithHeight(height uint64) {
 
 func (node *Node) WaitForStop() {
 	<-node.stop
+	node.close()
+}
+
+// close destroys the sec store and closes the stats database, it is called on the node shutdown
+func (node *Node) close() {
 	node.secStore.Destroy()
+	if closer, ok := node.statsStore.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			node.log.Error("Failed to close stats database", "err", err)
+		}
+	}
+}
+
+// Close releases the node databases, it is called when the node exits without being started
+func (node *Node) Close() {
+	node.close()
+	if err := node.db.Close(); err != nil {
+		node.log.Error("Failed to close database", "err", err)
+	}
 }
 
 func startInitialRPC(nodeConfig *config.Config, nodeState *state2.NodeState) (net.Listener, *rpc.Server, *http.Server, error) {
@@ -360,6 +430,10 @@ func (node *Node) startRPC() error {
 	if err := node.startHTTP(node.config.RPC.HTTPEndpoint(), apis, node.config.RPC.HTTPModules, node.config.RPC.HTTPCors, node.config.RPC.HTTPVirtualHosts, node.config.RPC.HTTPTimeouts, node.config.RPC.APIKey); err != nil {
 		return err
 	}
+	if err := node.startWS(node.config.RPC.WSEndpoint(), apis, node.config.RPC.WSModules, node.config.RPC.WSOrigins, node.config.RPC.APIKey); err != nil {
+		node.stopHTTP()
+		return err
+	}
 
 	node.rpcAPIs = apis
 	return nil
@@ -384,6 +458,24 @@ func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, c
 	return nil
 }
 
+// startWS initializes and starts the websocket RPC endpoint.
+func (node *Node) startWS(endpoint string, apis []rpc.API, modules []string, wsOrigins []string, apiKey string) error {
+	// Short circuit if the WS endpoint isn't being exposed
+	if endpoint == "" {
+		return nil
+	}
+	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, false, apiKey)
+	if err != nil {
+		return err
+	}
+	node.log.Info("WebSocket endpoint opened", "url", fmt.Sprintf("ws://%s", listener.Addr()))
+
+	node.wsListener = listener
+	node.wsHandler = handler
+
+	return nil
+}
+
 func (node *Node) stopInitialRPC() {
 	node.stopHTTP()
 }
@@ -417,10 +509,13 @@ func OpenDatabase(datadir string, name string, cache int, handles int) (db.DB, e
 
 // apis returns the collection of RPC descriptors this node offers.
 func (node *Node) apis() []rpc.API {
+	if node.config.Sync.LightMode {
+		return node.lightApis()
+	}
 
 	baseApi := api.NewBaseApi(node.consensusEngine, node.txpool, node.keyStore, node.secStore, node.ipfsProxy)
 
-	return []rpc.API{
+	apis := []rpc.API{
 		{
 			Namespace: "net",
 			Version:   "1.0",
@@ -433,6 +528,12 @@ func (node *Node) apis() []rpc.API {
 			Service:   api.NewDnaApi(baseApi, node.blockchain, node.ceremony, node.appVersion, node.profileManager),
 			Public:    true,
 		},
+		{
+			Namespace: "dna",
+			Version:   "1.0",
+			Service:   api.NewDnaSubscriptionApi(node.bus, baseApi),
+			Public:    true,
+		},
 		{
 			Namespace: "account",
 			Version:   "1.0",
@@ -451,6 +552,12 @@ func (node *Node) apis() []rpc.API {
 			Service:   api.NewBlockchainApi(baseApi, node.blockchain, node.ipfsProxy, node.txpool, node.downloader, node.pm),
 			Public:    true,
 		},
+		{
+			Namespace: "bcn",
+			Version:   "1.0",
+			Service:   api.NewBlockchainSubscriptionApi(node.bus),
+			Public:    true,
+		},
 		{
 			Namespace: "ipfs",
 			Version:   "1.0",
@@ -463,5 +570,44 @@ func (node *Node) apis() []rpc.API {
 			Service:   api.NewContractApi(baseApi, node.blockchain, node.deferJob, node.subManager),
 			Public:    true,
 		},
+		{
+			Namespace: "contract",
+			Version:   "1.0",
+			Service:   api.NewContractSubscriptionApi(node.bus),
+			Public:    true,
+		},
+	}
+	if node.statsStore != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "stats",
+			Version:   "1.0",
+			Service:   api.NewStatsApi(node.statsStore),
+			Public:    true,
+		})
+	}
+	return apis
+}
+
+// lightApis are served by light node, they don't depend on the state which is not kept by the node
+func (node *Node) lightApis() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "net",
+			Version:   "1.0",
+			Service:   api.NewNetApi(node.pm, node.ipfsProxy),
+			Public:    true,
+		},
+		{
+			Namespace: "bcn",
+			Version:   "1.0",
+			Service:   api.NewLightApi(node.blockchain, node.downloader, node.pm, node.consensusEngine),
+			Public:    true,
+		},
+		{
+			Namespace: "ipfs",
+			Version:   "1.0",
+			Service:   api.NewIpfsApi(node.ipfsProxy),
+			Public:    true,
+		},
 	}
 }
@@ -1416,6 +1416,132 @@ func (x *ProtoGetForkBlockRangeRequest) GetBlocks() [][]byte {
 	return nil
 }
 
+type ProtoGetStateProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Type      uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	Address   []byte `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ProtoGetStateProofRequest) Reset() {
+	*x = ProtoGetStateProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoGetStateProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoGetStateProofRequest) ProtoMessage() {}
+
+func (x *ProtoGetStateProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoGetStateProofRequest.ProtoReflect.Descriptor instead.
+func (*ProtoGetStateProofRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProtoGetStateProofRequest) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ProtoGetStateProofRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoGetStateProofRequest) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ProtoGetStateProofRequest) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ProtoStateProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId uint32 `protobuf:"varint,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Proof     []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *ProtoStateProof) Reset() {
+	*x = ProtoStateProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateProof) ProtoMessage() {}
+
+func (x *ProtoStateProof) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateProof.ProtoReflect.Descriptor instead.
+func (*ProtoStateProof) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProtoStateProof) GetRequestId() uint32 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ProtoStateProof) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 type ProtoFlip struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1429,7 +1555,7 @@ type ProtoFlip struct {
 func (x *ProtoFlip) Reset() {
 	*x = ProtoFlip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[25]
+		mi := &file_protobuf_models_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +1568,7 @@ func (x *ProtoFlip) String() string {
 func (*ProtoFlip) ProtoMessage() {}
 
 func (x *ProtoFlip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[25]
+	mi := &file_protobuf_models_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +1581,7 @@ func (x *ProtoFlip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlip.ProtoReflect.Descriptor instead.
 func (*ProtoFlip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{25}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ProtoFlip) GetTransaction() *ProtoTransaction {
@@ -1491,7 +1617,7 @@ type ProtoFlipKey struct {
 func (x *ProtoFlipKey) Reset() {
 	*x = ProtoFlipKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[26]
+		mi := &file_protobuf_models_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1504,7 +1630,7 @@ func (x *ProtoFlipKey) String() string {
 func (*ProtoFlipKey) ProtoMessage() {}
 
 func (x *ProtoFlipKey) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[26]
+	mi := &file_protobuf_models_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +1643,7 @@ func (x *ProtoFlipKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipKey.ProtoReflect.Descriptor instead.
 func (*ProtoFlipKey) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{26}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ProtoFlipKey) GetData() *ProtoFlipKey_Data {
@@ -1547,7 +1673,7 @@ type ProtoManifest struct {
 func (x *ProtoManifest) Reset() {
 	*x = ProtoManifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[27]
+		mi := &file_protobuf_models_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1560,7 +1686,7 @@ func (x *ProtoManifest) String() string {
 func (*ProtoManifest) ProtoMessage() {}
 
 func (x *ProtoManifest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[27]
+	mi := &file_protobuf_models_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,7 +1699,7 @@ func (x *ProtoManifest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoManifest.ProtoReflect.Descriptor instead.
 func (*ProtoManifest) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{27}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ProtoManifest) GetHeight() uint64 {
@@ -1609,7 +1735,7 @@ type ProtoPrivateFlipKeysPackage struct {
 func (x *ProtoPrivateFlipKeysPackage) Reset() {
 	*x = ProtoPrivateFlipKeysPackage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[28]
+		mi := &file_protobuf_models_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +1748,7 @@ func (x *ProtoPrivateFlipKeysPackage) String() string {
 func (*ProtoPrivateFlipKeysPackage) ProtoMessage() {}
 
 func (x *ProtoPrivateFlipKeysPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[28]
+	mi := &file_protobuf_models_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1761,7 @@ func (x *ProtoPrivateFlipKeysPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPrivateFlipKeysPackage.ProtoReflect.Descriptor instead.
 func (*ProtoPrivateFlipKeysPackage) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{28}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ProtoPrivateFlipKeysPackage) GetData() *ProtoPrivateFlipKeysPackage_Data {
@@ -1664,7 +1790,7 @@ type ProtoPullPushHash struct {
 func (x *ProtoPullPushHash) Reset() {
 	*x = ProtoPullPushHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[29]
+		mi := &file_protobuf_models_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1677,7 +1803,7 @@ func (x *ProtoPullPushHash) String() string {
 func (*ProtoPullPushHash) ProtoMessage() {}
 
 func (x *ProtoPullPushHash) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[29]
+	mi := &file_protobuf_models_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,7 +1816,7 @@ func (x *ProtoPullPushHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPullPushHash.ProtoReflect.Descriptor instead.
 func (*ProtoPullPushHash) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{29}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ProtoPullPushHash) GetType() uint32 {
@@ -1721,7 +1847,7 @@ type ProtoSnapshotManifestDb struct {
 func (x *ProtoSnapshotManifestDb) Reset() {
 	*x = ProtoSnapshotManifestDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[30]
+		mi := &file_protobuf_models_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1734,7 +1860,7 @@ func (x *ProtoSnapshotManifestDb) String() string {
 func (*ProtoSnapshotManifestDb) ProtoMessage() {}
 
 func (x *ProtoSnapshotManifestDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[30]
+	mi := &file_protobuf_models_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,7 +1873,7 @@ func (x *ProtoSnapshotManifestDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSnapshotManifestDb.ProtoReflect.Descriptor instead.
 func (*ProtoSnapshotManifestDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{30}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ProtoSnapshotManifestDb) GetHeight() uint64 {
@@ -1790,7 +1916,7 @@ type ProtoShortAnswerDb struct {
 func (x *ProtoShortAnswerDb) Reset() {
 	*x = ProtoShortAnswerDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[31]
+		mi := &file_protobuf_models_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1803,7 +1929,7 @@ func (x *ProtoShortAnswerDb) String() string {
 func (*ProtoShortAnswerDb) ProtoMessage() {}
 
 func (x *ProtoShortAnswerDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[31]
+	mi := &file_protobuf_models_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +1942,7 @@ func (x *ProtoShortAnswerDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoShortAnswerDb.ProtoReflect.Descriptor instead.
 func (*ProtoShortAnswerDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{31}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ProtoShortAnswerDb) GetHash() []byte {
@@ -1844,7 +1970,7 @@ type ProtoAnswersDb struct {
 func (x *ProtoAnswersDb) Reset() {
 	*x = ProtoAnswersDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[32]
+		mi := &file_protobuf_models_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1857,7 +1983,7 @@ func (x *ProtoAnswersDb) String() string {
 func (*ProtoAnswersDb) ProtoMessage() {}
 
 func (x *ProtoAnswersDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[32]
+	mi := &file_protobuf_models_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +1996,7 @@ func (x *ProtoAnswersDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoAnswersDb.ProtoReflect.Descriptor instead.
 func (*ProtoAnswersDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ProtoAnswersDb) GetAnswers() []*ProtoAnswersDb_Answer {
@@ -1893,7 +2019,7 @@ type ProtoBurntCoins struct {
 func (x *ProtoBurntCoins) Reset() {
 	*x = ProtoBurntCoins{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[33]
+		mi := &file_protobuf_models_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1906,7 +2032,7 @@ func (x *ProtoBurntCoins) String() string {
 func (*ProtoBurntCoins) ProtoMessage() {}
 
 func (x *ProtoBurntCoins) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[33]
+	mi := &file_protobuf_models_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1919,7 +2045,7 @@ func (x *ProtoBurntCoins) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoBurntCoins.ProtoReflect.Descriptor instead.
 func (*ProtoBurntCoins) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ProtoBurntCoins) GetAddress() []byte {
@@ -1957,7 +2083,7 @@ type ProtoSavedTransaction struct {
 func (x *ProtoSavedTransaction) Reset() {
 	*x = ProtoSavedTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[34]
+		mi := &file_protobuf_models_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1970,7 +2096,7 @@ func (x *ProtoSavedTransaction) String() string {
 func (*ProtoSavedTransaction) ProtoMessage() {}
 
 func (x *ProtoSavedTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[34]
+	mi := &file_protobuf_models_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,7 +2109,7 @@ func (x *ProtoSavedTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSavedTransaction.ProtoReflect.Descriptor instead.
 func (*ProtoSavedTransaction) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProtoSavedTransaction) GetTx() *ProtoTransaction {
@@ -2026,7 +2152,7 @@ type ProtoActivityMonitor struct {
 func (x *ProtoActivityMonitor) Reset() {
 	*x = ProtoActivityMonitor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[35]
+		mi := &file_protobuf_models_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2039,7 +2165,7 @@ func (x *ProtoActivityMonitor) String() string {
 func (*ProtoActivityMonitor) ProtoMessage() {}
 
 func (x *ProtoActivityMonitor) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[35]
+	mi := &file_protobuf_models_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,7 +2178,7 @@ func (x *ProtoActivityMonitor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoActivityMonitor.ProtoReflect.Descriptor instead.
 func (*ProtoActivityMonitor) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProtoActivityMonitor) GetTimestamp() int64 {
@@ -2082,7 +2208,7 @@ type ProtoShortAnswerAttachment struct {
 func (x *ProtoShortAnswerAttachment) Reset() {
 	*x = ProtoShortAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[36]
+		mi := &file_protobuf_models_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2095,7 +2221,7 @@ func (x *ProtoShortAnswerAttachment) String() string {
 func (*ProtoShortAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[36]
+	mi := &file_protobuf_models_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2108,7 +2234,7 @@ func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoShortAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoShortAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ProtoShortAnswerAttachment) GetAnswers() []byte {
@@ -2146,7 +2272,7 @@ type ProtoLongAnswerAttachment struct {
 func (x *ProtoLongAnswerAttachment) Reset() {
 	*x = ProtoLongAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[37]
+		mi := &file_protobuf_models_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2159,7 +2285,7 @@ func (x *ProtoLongAnswerAttachment) String() string {
 func (*ProtoLongAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[37]
+	mi := &file_protobuf_models_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2298,7 @@ func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoLongAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoLongAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ProtoLongAnswerAttachment) GetAnswers() []byte {
@@ -2215,7 +2341,7 @@ type ProtoFlipSubmitAttachment struct {
 func (x *ProtoFlipSubmitAttachment) Reset() {
 	*x = ProtoFlipSubmitAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[38]
+		mi := &file_protobuf_models_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2228,7 +2354,7 @@ func (x *ProtoFlipSubmitAttachment) String() string {
 func (*ProtoFlipSubmitAttachment) ProtoMessage() {}
 
 func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[38]
+	mi := &file_protobuf_models_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,7 +2367,7 @@ func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipSubmitAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoFlipSubmitAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ProtoFlipSubmitAttachment) GetCid() []byte {
@@ -2269,7 +2395,7 @@ type ProtoOnlineStatusAttachment struct {
 func (x *ProtoOnlineStatusAttachment) Reset() {
 	*x = ProtoOnlineStatusAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[39]
+		mi := &file_protobuf_models_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2282,7 +2408,7 @@ func (x *ProtoOnlineStatusAttachment) String() string {
 func (*ProtoOnlineStatusAttachment) ProtoMessage() {}
 
 func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[39]
+	mi := &file_protobuf_models_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +2421,7 @@ func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoOnlineStatusAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoOnlineStatusAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ProtoOnlineStatusAttachment) GetOnline() bool {
@@ -2316,7 +2442,7 @@ type ProtoBurnAttachment struct {
 func (x *ProtoBurnAttachment) Reset() {
 	*x = ProtoBurnAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[40]
+		mi := &file_protobuf_models_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2329,7 +2455,7 @@ func (x *ProtoBurnAttachment) String() string {
 func (*ProtoBurnAttachment) ProtoMessage() {}
 
 func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[40]
+	mi := &file_protobuf_models_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +2468,7 @@ func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoBurnAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoBurnAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ProtoBurnAttachment) GetKey() string {
@@ -2363,7 +2489,7 @@ type ProtoChangeProfileAttachment struct {
 func (x *ProtoChangeProfileAttachment) Reset() {
 	*x = ProtoChangeProfileAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[41]
+		mi := &file_protobuf_models_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2376,7 +2502,7 @@ func (x *ProtoChangeProfileAttachment) String() string {
 func (*ProtoChangeProfileAttachment) ProtoMessage() {}
 
 func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[41]
+	mi := &file_protobuf_models_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,7 +2515,7 @@ func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoChangeProfileAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoChangeProfileAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ProtoChangeProfileAttachment) GetHash() []byte {
@@ -2410,7 +2536,7 @@ type ProtoDeleteFlipAttachment struct {
 func (x *ProtoDeleteFlipAttachment) Reset() {
 	*x = ProtoDeleteFlipAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[42]
+		mi := &file_protobuf_models_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2423,7 +2549,7 @@ func (x *ProtoDeleteFlipAttachment) String() string {
 func (*ProtoDeleteFlipAttachment) ProtoMessage() {}
 
 func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[42]
+	mi := &file_protobuf_models_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +2562,7 @@ func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeleteFlipAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeleteFlipAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ProtoDeleteFlipAttachment) GetCid() []byte {
@@ -2460,7 +2586,7 @@ type ProtoStateAccount struct {
 func (x *ProtoStateAccount) Reset() {
 	*x = ProtoStateAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[43]
+		mi := &file_protobuf_models_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2473,7 +2599,7 @@ func (x *ProtoStateAccount) String() string {
 func (*ProtoStateAccount) ProtoMessage() {}
 
 func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[43]
+	mi := &file_protobuf_models_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +2612,7 @@ func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateAccount.ProtoReflect.Descriptor instead.
 func (*ProtoStateAccount) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ProtoStateAccount) GetNonce() uint32 {
@@ -2551,7 +2677,7 @@ type ProtoStateIdentity struct {
 func (x *ProtoStateIdentity) Reset() {
 	*x = ProtoStateIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[44]
+		mi := &file_protobuf_models_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2564,7 +2690,7 @@ func (x *ProtoStateIdentity) String() string {
 func (*ProtoStateIdentity) ProtoMessage() {}
 
 func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[44]
+	mi := &file_protobuf_models_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,7 +2703,7 @@ func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ProtoStateIdentity) GetStake() []byte {
@@ -2774,7 +2900,7 @@ type ProtoStateGlobal struct {
 func (x *ProtoStateGlobal) Reset() {
 	*x = ProtoStateGlobal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[45]
+		mi := &file_protobuf_models_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2787,7 +2913,7 @@ func (x *ProtoStateGlobal) String() string {
 func (*ProtoStateGlobal) ProtoMessage() {}
 
 func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[45]
+	mi := &file_protobuf_models_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,7 +2926,7 @@ func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateGlobal.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ProtoStateGlobal) GetEpoch() uint32 {
@@ -2929,7 +3055,7 @@ type ProtoStateApprovedIdentity struct {
 func (x *ProtoStateApprovedIdentity) Reset() {
 	*x = ProtoStateApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[46]
+		mi := &file_protobuf_models_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2942,7 +3068,7 @@ func (x *ProtoStateApprovedIdentity) String() string {
 func (*ProtoStateApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[46]
+	mi := &file_protobuf_models_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2955,7 +3081,7 @@ func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ProtoStateApprovedIdentity) GetValidated() bool {
@@ -2997,7 +3123,7 @@ type ProtoStateIdentityStatusSwitch struct {
 func (x *ProtoStateIdentityStatusSwitch) Reset() {
 	*x = ProtoStateIdentityStatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[47]
+		mi := &file_protobuf_models_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3010,7 +3136,7 @@ func (x *ProtoStateIdentityStatusSwitch) String() string {
 func (*ProtoStateIdentityStatusSwitch) ProtoMessage() {}
 
 func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[47]
+	mi := &file_protobuf_models_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3023,7 +3149,7 @@ func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentityStatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentityStatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ProtoStateIdentityStatusSwitch) GetAddresses() [][]byte {
@@ -3044,7 +3170,7 @@ type ProtoStateDelegationSwitch struct {
 func (x *ProtoStateDelegationSwitch) Reset() {
 	*x = ProtoStateDelegationSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[48]
+		mi := &file_protobuf_models_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3057,7 +3183,7 @@ func (x *ProtoStateDelegationSwitch) String() string {
 func (*ProtoStateDelegationSwitch) ProtoMessage() {}
 
 func (x *ProtoStateDelegationSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[48]
+	mi := &file_protobuf_models_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,7 +3196,7 @@ func (x *ProtoStateDelegationSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateDelegationSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelegationSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{48}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ProtoStateDelegationSwitch) GetDelegations() []*ProtoStateDelegationSwitch_Delegation {
@@ -3091,7 +3217,7 @@ type ProtoStateDelayedPenalties struct {
 func (x *ProtoStateDelayedPenalties) Reset() {
 	*x = ProtoStateDelayedPenalties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[49]
+		mi := &file_protobuf_models_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3104,7 +3230,7 @@ func (x *ProtoStateDelayedPenalties) String() string {
 func (*ProtoStateDelayedPenalties) ProtoMessage() {}
 
 func (x *ProtoStateDelayedPenalties) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[49]
+	mi := &file_protobuf_models_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3117,7 +3243,7 @@ func (x *ProtoStateDelayedPenalties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateDelayedPenalties.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelayedPenalties) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{49}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ProtoStateDelayedPenalties) GetIdentities() [][]byte {
@@ -3145,7 +3271,7 @@ type ProtoPredefinedState struct {
 func (x *ProtoPredefinedState) Reset() {
 	*x = ProtoPredefinedState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[50]
+		mi := &file_protobuf_models_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3158,7 +3284,7 @@ func (x *ProtoPredefinedState) String() string {
 func (*ProtoPredefinedState) ProtoMessage() {}
 
 func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[50]
+	mi := &file_protobuf_models_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3171,7 +3297,7 @@ func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ProtoPredefinedState) GetBlock() uint64 {
@@ -3242,7 +3368,7 @@ type ProtoCallContractAttachment struct {
 func (x *ProtoCallContractAttachment) Reset() {
 	*x = ProtoCallContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[51]
+		mi := &file_protobuf_models_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3255,7 +3381,7 @@ func (x *ProtoCallContractAttachment) String() string {
 func (*ProtoCallContractAttachment) ProtoMessage() {}
 
 func (x *ProtoCallContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[51]
+	mi := &file_protobuf_models_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3268,7 +3394,7 @@ func (x *ProtoCallContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoCallContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoCallContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ProtoCallContractAttachment) GetMethod() string {
@@ -3297,7 +3423,7 @@ type ProtoDeployContractAttachment struct {
 func (x *ProtoDeployContractAttachment) Reset() {
 	*x = ProtoDeployContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[52]
+		mi := &file_protobuf_models_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3310,7 +3436,7 @@ func (x *ProtoDeployContractAttachment) String() string {
 func (*ProtoDeployContractAttachment) ProtoMessage() {}
 
 func (x *ProtoDeployContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[52]
+	mi := &file_protobuf_models_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3323,7 +3449,7 @@ func (x *ProtoDeployContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeployContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeployContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{52}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ProtoDeployContractAttachment) GetCodeHash() []byte {
@@ -3351,7 +3477,7 @@ type ProtoTerminateContractAttachment struct {
 func (x *ProtoTerminateContractAttachment) Reset() {
 	*x = ProtoTerminateContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[53]
+		mi := &file_protobuf_models_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3364,7 +3490,7 @@ func (x *ProtoTerminateContractAttachment) String() string {
 func (*ProtoTerminateContractAttachment) ProtoMessage() {}
 
 func (x *ProtoTerminateContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[53]
+	mi := &file_protobuf_models_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3377,7 +3503,7 @@ func (x *ProtoTerminateContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTerminateContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoTerminateContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{53}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ProtoTerminateContractAttachment) GetArgs() [][]byte {
@@ -3399,7 +3525,7 @@ type ProtoStoreToIpfsAttachment struct {
 func (x *ProtoStoreToIpfsAttachment) Reset() {
 	*x = ProtoStoreToIpfsAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[54]
+		mi := &file_protobuf_models_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3412,7 +3538,7 @@ func (x *ProtoStoreToIpfsAttachment) String() string {
 func (*ProtoStoreToIpfsAttachment) ProtoMessage() {}
 
 func (x *ProtoStoreToIpfsAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[54]
+	mi := &file_protobuf_models_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3425,7 +3551,7 @@ func (x *ProtoStoreToIpfsAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStoreToIpfsAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoStoreToIpfsAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{54}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ProtoStoreToIpfsAttachment) GetCid() []byte {
@@ -3453,7 +3579,7 @@ type ProtoTxReceipts struct {
 func (x *ProtoTxReceipts) Reset() {
 	*x = ProtoTxReceipts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[55]
+		mi := &file_protobuf_models_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3466,7 +3592,7 @@ func (x *ProtoTxReceipts) String() string {
 func (*ProtoTxReceipts) ProtoMessage() {}
 
 func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[55]
+	mi := &file_protobuf_models_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3479,7 +3605,7 @@ func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceipts.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceipts) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{55}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ProtoTxReceipts) GetReceipts() []*ProtoTxReceipts_ProtoTxReceipt {
@@ -3501,7 +3627,7 @@ type ProtoTxReceiptIndex struct {
 func (x *ProtoTxReceiptIndex) Reset() {
 	*x = ProtoTxReceiptIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[56]
+		mi := &file_protobuf_models_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3514,7 +3640,7 @@ func (x *ProtoTxReceiptIndex) String() string {
 func (*ProtoTxReceiptIndex) ProtoMessage() {}
 
 func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[56]
+	mi := &file_protobuf_models_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,7 +3653,7 @@ func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceiptIndex.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceiptIndex) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{56}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ProtoTxReceiptIndex) GetCid() []byte {
@@ -3555,7 +3681,7 @@ type ProtoDeferredTxs struct {
 func (x *ProtoDeferredTxs) Reset() {
 	*x = ProtoDeferredTxs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[57]
+		mi := &file_protobuf_models_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3568,7 +3694,7 @@ func (x *ProtoDeferredTxs) String() string {
 func (*ProtoDeferredTxs) ProtoMessage() {}
 
 func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[57]
+	mi := &file_protobuf_models_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3581,7 +3707,7 @@ func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeferredTxs.ProtoReflect.Descriptor instead.
 func (*ProtoDeferredTxs) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{57}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ProtoDeferredTxs) GetTxs() []*ProtoDeferredTxs_ProtoDeferredTx {
@@ -3604,7 +3730,7 @@ type ProtoSavedEvent struct {
 func (x *ProtoSavedEvent) Reset() {
 	*x = ProtoSavedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[58]
+		mi := &file_protobuf_models_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3617,7 +3743,7 @@ func (x *ProtoSavedEvent) String() string {
 func (*ProtoSavedEvent) ProtoMessage() {}
 
 func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[58]
+	mi := &file_protobuf_models_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +3756,7 @@ func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoSavedEvent.ProtoReflect.Descriptor instead.
 func (*ProtoSavedEvent) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{58}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ProtoSavedEvent) GetContract() []byte {
@@ -3665,7 +3791,7 @@ type ProtoUpgradeVotes struct {
 func (x *ProtoUpgradeVotes) Reset() {
 	*x = ProtoUpgradeVotes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[59]
+		mi := &file_protobuf_models_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3678,7 +3804,7 @@ func (x *ProtoUpgradeVotes) String() string {
 func (*ProtoUpgradeVotes) ProtoMessage() {}
 
 func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[59]
+	mi := &file_protobuf_models_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,7 +3817,7 @@ func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoUpgradeVotes.ProtoReflect.Descriptor instead.
 func (*ProtoUpgradeVotes) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{59}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ProtoUpgradeVotes) GetVotes() []*ProtoUpgradeVotes_ProtoUpgradeVote {
@@ -3712,7 +3838,7 @@ type ProtoLotteryIdentitiesDb struct {
 func (x *ProtoLotteryIdentitiesDb) Reset() {
 	*x = ProtoLotteryIdentitiesDb{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[60]
+		mi := &file_protobuf_models_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3725,7 +3851,7 @@ func (x *ProtoLotteryIdentitiesDb) String() string {
 func (*ProtoLotteryIdentitiesDb) ProtoMessage() {}
 
 func (x *ProtoLotteryIdentitiesDb) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[60]
+	mi := &file_protobuf_models_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3738,7 +3864,7 @@ func (x *ProtoLotteryIdentitiesDb) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoLotteryIdentitiesDb.ProtoReflect.Descriptor instead.
 func (*ProtoLotteryIdentitiesDb) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{60}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ProtoLotteryIdentitiesDb) GetIdentities() []*ProtoLotteryIdentitiesDb_Identity {
@@ -3766,7 +3892,7 @@ type ProtoTransaction_Data struct {
 func (x *ProtoTransaction_Data) Reset() {
 	*x = ProtoTransaction_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[61]
+		mi := &file_protobuf_models_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3779,7 +3905,7 @@ func (x *ProtoTransaction_Data) String() string {
 func (*ProtoTransaction_Data) ProtoMessage() {}
 
 func (x *ProtoTransaction_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[61]
+	mi := &file_protobuf_models_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,7 +4003,7 @@ type ProtoBlockHeader_Proposed struct {
 func (x *ProtoBlockHeader_Proposed) Reset() {
 	*x = ProtoBlockHeader_Proposed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[62]
+		mi := &file_protobuf_models_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3890,7 +4016,7 @@ func (x *ProtoBlockHeader_Proposed) String() string {
 func (*ProtoBlockHeader_Proposed) ProtoMessage() {}
 
 func (x *ProtoBlockHeader_Proposed) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[62]
+	mi := &file_protobuf_models_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4035,7 +4161,7 @@ type ProtoBlockHeader_Empty struct {
 func (x *ProtoBlockHeader_Empty) Reset() {
 	*x = ProtoBlockHeader_Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[63]
+		mi := &file_protobuf_models_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4048,7 +4174,7 @@ func (x *ProtoBlockHeader_Empty) String() string {
 func (*ProtoBlockHeader_Empty) ProtoMessage() {}
 
 func (x *ProtoBlockHeader_Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[63]
+	mi := &file_protobuf_models_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4126,7 +4252,7 @@ type ProtoBlockProposal_Data struct {
 func (x *ProtoBlockProposal_Data) Reset() {
 	*x = ProtoBlockProposal_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[64]
+		mi := &file_protobuf_models_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4139,7 +4265,7 @@ func (x *ProtoBlockProposal_Data) String() string {
 func (*ProtoBlockProposal_Data) ProtoMessage() {}
 
 func (x *ProtoBlockProposal_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[64]
+	mi := &file_protobuf_models_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4189,7 +4315,7 @@ type ProtoBlockCert_Signature struct {
 func (x *ProtoBlockCert_Signature) Reset() {
 	*x = ProtoBlockCert_Signature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[65]
+		mi := &file_protobuf_models_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4202,7 +4328,7 @@ func (x *ProtoBlockCert_Signature) String() string {
 func (*ProtoBlockCert_Signature) ProtoMessage() {}
 
 func (x *ProtoBlockCert_Signature) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[65]
+	mi := &file_protobuf_models_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4251,7 +4377,7 @@ type ProtoMsgBatch_BatchItem struct {
 func (x *ProtoMsgBatch_BatchItem) Reset() {
 	*x = ProtoMsgBatch_BatchItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[66]
+		mi := &file_protobuf_models_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4264,7 +4390,7 @@ func (x *ProtoMsgBatch_BatchItem) String() string {
 func (*ProtoMsgBatch_BatchItem) ProtoMessage() {}
 
 func (x *ProtoMsgBatch_BatchItem) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[66]
+	mi := &file_protobuf_models_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4307,7 +4433,7 @@ type ProtoIdentityStateDiff_IdentityStateDiffValue struct {
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) Reset() {
 	*x = ProtoIdentityStateDiff_IdentityStateDiffValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[67]
+		mi := &file_protobuf_models_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4320,7 +4446,7 @@ func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) String() string {
 func (*ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoMessage() {}
 
 func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[67]
+	mi := &file_protobuf_models_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4369,7 +4495,7 @@ type ProtoSnapshotBlock_KeyValue struct {
 func (x *ProtoSnapshotBlock_KeyValue) Reset() {
 	*x = ProtoSnapshotBlock_KeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[68]
+		mi := &file_protobuf_models_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4382,7 +4508,7 @@ func (x *ProtoSnapshotBlock_KeyValue) String() string {
 func (*ProtoSnapshotBlock_KeyValue) ProtoMessage() {}
 
 func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[68]
+	mi := &file_protobuf_models_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4427,7 +4553,7 @@ type ProtoSnapshotNodes_Node struct {
 func (x *ProtoSnapshotNodes_Node) Reset() {
 	*x = ProtoSnapshotNodes_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[69]
+		mi := &file_protobuf_models_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4440,7 +4566,7 @@ func (x *ProtoSnapshotNodes_Node) String() string {
 func (*ProtoSnapshotNodes_Node) ProtoMessage() {}
 
 func (x *ProtoSnapshotNodes_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[69]
+	mi := &file_protobuf_models_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4504,7 +4630,7 @@ type ProtoGossipBlockRange_Block struct {
 func (x *ProtoGossipBlockRange_Block) Reset() {
 	*x = ProtoGossipBlockRange_Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[70]
+		mi := &file_protobuf_models_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4517,7 +4643,7 @@ func (x *ProtoGossipBlockRange_Block) String() string {
 func (*ProtoGossipBlockRange_Block) ProtoMessage() {}
 
 func (x *ProtoGossipBlockRange_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[70]
+	mi := &file_protobuf_models_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4566,7 +4692,7 @@ type ProtoProposeProof_Data struct {
 func (x *ProtoProposeProof_Data) Reset() {
 	*x = ProtoProposeProof_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[71]
+		mi := &file_protobuf_models_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4579,7 +4705,7 @@ func (x *ProtoProposeProof_Data) String() string {
 func (*ProtoProposeProof_Data) ProtoMessage() {}
 
 func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[71]
+	mi := &file_protobuf_models_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4625,7 +4751,7 @@ type ProtoVote_Data struct {
 func (x *ProtoVote_Data) Reset() {
 	*x = ProtoVote_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[72]
+		mi := &file_protobuf_models_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4638,7 +4764,7 @@ func (x *ProtoVote_Data) String() string {
 func (*ProtoVote_Data) ProtoMessage() {}
 
 func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[72]
+	mi := &file_protobuf_models_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4708,7 +4834,7 @@ type ProtoFlipKey_Data struct {
 func (x *ProtoFlipKey_Data) Reset() {
 	*x = ProtoFlipKey_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[73]
+		mi := &file_protobuf_models_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4721,7 +4847,7 @@ func (x *ProtoFlipKey_Data) String() string {
 func (*ProtoFlipKey_Data) ProtoMessage() {}
 
 func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[73]
+	mi := &file_protobuf_models_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4734,7 +4860,7 @@ func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipKey_Data.ProtoReflect.Descriptor instead.
 func (*ProtoFlipKey_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{26, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *ProtoFlipKey_Data) GetKey() []byte {
@@ -4763,7 +4889,7 @@ type ProtoPrivateFlipKeysPackage_Data struct {
 func (x *ProtoPrivateFlipKeysPackage_Data) Reset() {
 	*x = ProtoPrivateFlipKeysPackage_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[74]
+		mi := &file_protobuf_models_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4776,7 +4902,7 @@ func (x *ProtoPrivateFlipKeysPackage_Data) String() string {
 func (*ProtoPrivateFlipKeysPackage_Data) ProtoMessage() {}
 
 func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[74]
+	mi := &file_protobuf_models_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4789,7 +4915,7 @@ func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPrivateFlipKeysPackage_Data.ProtoReflect.Descriptor instead.
 func (*ProtoPrivateFlipKeysPackage_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{28, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *ProtoPrivateFlipKeysPackage_Data) GetPackage() []byte {
@@ -4818,7 +4944,7 @@ type ProtoAnswersDb_Answer struct {
 func (x *ProtoAnswersDb_Answer) Reset() {
 	*x = ProtoAnswersDb_Answer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[75]
+		mi := &file_protobuf_models_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4831,7 +4957,7 @@ func (x *ProtoAnswersDb_Answer) String() string {
 func (*ProtoAnswersDb_Answer) ProtoMessage() {}
 
 func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[75]
+	mi := &file_protobuf_models_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4844,7 +4970,7 @@ func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoAnswersDb_Answer.ProtoReflect.Descriptor instead.
 func (*ProtoAnswersDb_Answer) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{32, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *ProtoAnswersDb_Answer) GetAddress() []byte {
@@ -4873,7 +4999,7 @@ type ProtoActivityMonitor_Activity struct {
 func (x *ProtoActivityMonitor_Activity) Reset() {
 	*x = ProtoActivityMonitor_Activity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[76]
+		mi := &file_protobuf_models_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4886,7 +5012,7 @@ func (x *ProtoActivityMonitor_Activity) String() string {
 func (*ProtoActivityMonitor_Activity) ProtoMessage() {}
 
 func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[76]
+	mi := &file_protobuf_models_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4899,7 +5025,7 @@ func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoActivityMonitor_Activity.ProtoReflect.Descriptor instead.
 func (*ProtoActivityMonitor_Activity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{35, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{37, 0}
 }
 
 func (x *ProtoActivityMonitor_Activity) GetAddress() []byte {
@@ -4928,7 +5054,7 @@ type ProtoStateAccount_ProtoContractData struct {
 func (x *ProtoStateAccount_ProtoContractData) Reset() {
 	*x = ProtoStateAccount_ProtoContractData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[77]
+		mi := &file_protobuf_models_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4941,7 +5067,7 @@ func (x *ProtoStateAccount_ProtoContractData) String() string {
 func (*ProtoStateAccount_ProtoContractData) ProtoMessage() {}
 
 func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[77]
+	mi := &file_protobuf_models_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4954,7 +5080,7 @@ func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ProtoStateAccount_ProtoContractData.ProtoReflect.Descriptor instead.
 func (*ProtoStateAccount_ProtoContractData) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{43, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{45, 0}
 }
 
 func (x *ProtoStateAccount_ProtoContractData) GetCodeHash() []byte {
@@ -4983,7 +5109,7 @@ type ProtoStateIdentity_Flip struct {
 func (x *ProtoStateIdentity_Flip) Reset() {
 	*x = ProtoStateIdentity_Flip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[78]
+		mi := &file_protobuf_models_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4996,7 +5122,7 @@ func (x *ProtoStateIdentity_Flip) String() string {
 func (*ProtoStateIdentity_Flip) ProtoMessage() {}
 
 func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[78]
+	mi := &file_protobuf_models_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5009,7 +5135,7 @@ func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity_Flip.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *ProtoStateIdentity_Flip) GetCid() []byte {
@@ -5038,7 +5164,7 @@ type ProtoStateIdentity_TxAddr struct {
 func (x *ProtoStateIdentity_TxAddr) Reset() {
 	*x = ProtoStateIdentity_TxAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[79]
+		mi := &file_protobuf_models_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5051,7 +5177,7 @@ func (x *ProtoStateIdentity_TxAddr) String() string {
 func (*ProtoStateIdentity_TxAddr) ProtoMessage() {}
 
 func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[79]
+	mi := &file_protobuf_models_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5064,7 +5190,7 @@ func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity_TxAddr.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 1}
 }
 
 func (x *ProtoStateIdentity_TxAddr) GetHash() []byte {
@@ -5094,7 +5220,7 @@ type ProtoStateIdentity_Inviter struct {
 func (x *ProtoStateIdentity_Inviter) Reset() {
 	*x = ProtoStateIdentity_Inviter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[80]
+		mi := &file_protobuf_models_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5107,7 +5233,7 @@ func (x *ProtoStateIdentity_Inviter) String() string {
 func (*ProtoStateIdentity_Inviter) ProtoMessage() {}
 
 func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[80]
+	mi := &file_protobuf_models_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5120,7 +5246,7 @@ func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity_Inviter.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity_Inviter) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 2}
 }
 
 func (x *ProtoStateIdentity_Inviter) GetHash() []byte {
@@ -5156,7 +5282,7 @@ type ProtoStateGlobal_EmptyBlocksByShards struct {
 func (x *ProtoStateGlobal_EmptyBlocksByShards) Reset() {
 	*x = ProtoStateGlobal_EmptyBlocksByShards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[81]
+		mi := &file_protobuf_models_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5169,7 +5295,7 @@ func (x *ProtoStateGlobal_EmptyBlocksByShards) String() string {
 func (*ProtoStateGlobal_EmptyBlocksByShards) ProtoMessage() {}
 
 func (x *ProtoStateGlobal_EmptyBlocksByShards) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[81]
+	mi := &file_protobuf_models_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5182,7 +5308,7 @@ func (x *ProtoStateGlobal_EmptyBlocksByShards) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ProtoStateGlobal_EmptyBlocksByShards.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal_EmptyBlocksByShards) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 0}
 }
 
 func (x *ProtoStateGlobal_EmptyBlocksByShards) GetShardId() uint32 {
@@ -5211,7 +5337,7 @@ type ProtoStateGlobal_ShardSize struct {
 func (x *ProtoStateGlobal_ShardSize) Reset() {
 	*x = ProtoStateGlobal_ShardSize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[82]
+		mi := &file_protobuf_models_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5224,7 +5350,7 @@ func (x *ProtoStateGlobal_ShardSize) String() string {
 func (*ProtoStateGlobal_ShardSize) ProtoMessage() {}
 
 func (x *ProtoStateGlobal_ShardSize) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[82]
+	mi := &file_protobuf_models_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5237,7 +5363,7 @@ func (x *ProtoStateGlobal_ShardSize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateGlobal_ShardSize.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal_ShardSize) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 1}
 }
 
 func (x *ProtoStateGlobal_ShardSize) GetShardId() uint32 {
@@ -5266,7 +5392,7 @@ type ProtoStateDelegationSwitch_Delegation struct {
 func (x *ProtoStateDelegationSwitch_Delegation) Reset() {
 	*x = ProtoStateDelegationSwitch_Delegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[83]
+		mi := &file_protobuf_models_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5279,7 +5405,7 @@ func (x *ProtoStateDelegationSwitch_Delegation) String() string {
 func (*ProtoStateDelegationSwitch_Delegation) ProtoMessage() {}
 
 func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[83]
+	mi := &file_protobuf_models_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5292,7 +5418,7 @@ func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoStateDelegationSwitch_Delegation.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelegationSwitch_Delegation) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{48, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 0}
 }
 
 func (x *ProtoStateDelegationSwitch_Delegation) GetDelegator() []byte {
@@ -5333,7 +5459,7 @@ type ProtoPredefinedState_Global struct {
 func (x *ProtoPredefinedState_Global) Reset() {
 	*x = ProtoPredefinedState_Global{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[84]
+		mi := &file_protobuf_models_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5346,7 +5472,7 @@ func (x *ProtoPredefinedState_Global) String() string {
 func (*ProtoPredefinedState_Global) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[84]
+	mi := &file_protobuf_models_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5359,7 +5485,7 @@ func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Global.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Global) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 0}
 }
 
 func (x *ProtoPredefinedState_Global) GetEpoch() uint32 {
@@ -5471,7 +5597,7 @@ type ProtoPredefinedState_StatusSwitch struct {
 func (x *ProtoPredefinedState_StatusSwitch) Reset() {
 	*x = ProtoPredefinedState_StatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[85]
+		mi := &file_protobuf_models_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5484,7 +5610,7 @@ func (x *ProtoPredefinedState_StatusSwitch) String() string {
 func (*ProtoPredefinedState_StatusSwitch) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[85]
+	mi := &file_protobuf_models_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5497,7 +5623,7 @@ func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoPredefinedState_StatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_StatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 1}
 }
 
 func (x *ProtoPredefinedState_StatusSwitch) GetAddresses() [][]byte {
@@ -5522,7 +5648,7 @@ type ProtoPredefinedState_Account struct {
 func (x *ProtoPredefinedState_Account) Reset() {
 	*x = ProtoPredefinedState_Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[86]
+		mi := &file_protobuf_models_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5535,7 +5661,7 @@ func (x *ProtoPredefinedState_Account) String() string {
 func (*ProtoPredefinedState_Account) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[86]
+	mi := &file_protobuf_models_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5548,7 +5674,7 @@ func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Account.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Account) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 2}
 }
 
 func (x *ProtoPredefinedState_Account) GetAddress() []byte {
@@ -5621,7 +5747,7 @@ type ProtoPredefinedState_Identity struct {
 func (x *ProtoPredefinedState_Identity) Reset() {
 	*x = ProtoPredefinedState_Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[87]
+		mi := &file_protobuf_models_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5634,7 +5760,7 @@ func (x *ProtoPredefinedState_Identity) String() string {
 func (*ProtoPredefinedState_Identity) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[87]
+	mi := &file_protobuf_models_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5647,7 +5773,7 @@ func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState_Identity.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 3}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 3}
 }
 
 func (x *ProtoPredefinedState_Identity) GetAddress() []byte {
@@ -5840,7 +5966,7 @@ type ProtoPredefinedState_ApprovedIdentity struct {
 func (x *ProtoPredefinedState_ApprovedIdentity) Reset() {
 	*x = ProtoPredefinedState_ApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[88]
+		mi := &file_protobuf_models_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5853,7 +5979,7 @@ func (x *ProtoPredefinedState_ApprovedIdentity) String() string {
 func (*ProtoPredefinedState_ApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[88]
+	mi := &file_protobuf_models_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5866,7 +5992,7 @@ func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_ApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_ApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 4}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 4}
 }
 
 func (x *ProtoPredefinedState_ApprovedIdentity) GetAddress() []byte {
@@ -5916,7 +6042,7 @@ type ProtoPredefinedState_ContractKeyValue struct {
 func (x *ProtoPredefinedState_ContractKeyValue) Reset() {
 	*x = ProtoPredefinedState_ContractKeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[89]
+		mi := &file_protobuf_models_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5929,7 +6055,7 @@ func (x *ProtoPredefinedState_ContractKeyValue) String() string {
 func (*ProtoPredefinedState_ContractKeyValue) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[89]
+	mi := &file_protobuf_models_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5942,7 +6068,7 @@ func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_ContractKeyValue.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_ContractKeyValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 5}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 5}
 }
 
 func (x *ProtoPredefinedState_ContractKeyValue) GetKey() []byte {
@@ -5971,7 +6097,7 @@ type ProtoPredefinedState_Account_ContractData struct {
 func (x *ProtoPredefinedState_Account_ContractData) Reset() {
 	*x = ProtoPredefinedState_Account_ContractData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[90]
+		mi := &file_protobuf_models_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5984,7 +6110,7 @@ func (x *ProtoPredefinedState_Account_ContractData) String() string {
 func (*ProtoPredefinedState_Account_ContractData) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[90]
+	mi := &file_protobuf_models_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5997,7 +6123,7 @@ func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.
 
 // Deprecated: Use ProtoPredefinedState_Account_ContractData.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Account_ContractData) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 2, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 2, 0}
 }
 
 func (x *ProtoPredefinedState_Account_ContractData) GetCodeHash() []byte {
@@ -6026,7 +6152,7 @@ type ProtoPredefinedState_Identity_Flip struct {
 func (x *ProtoPredefinedState_Identity_Flip) Reset() {
 	*x = ProtoPredefinedState_Identity_Flip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[91]
+		mi := &file_protobuf_models_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6039,7 +6165,7 @@ func (x *ProtoPredefinedState_Identity_Flip) String() string {
 func (*ProtoPredefinedState_Identity_Flip) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[91]
+	mi := &file_protobuf_models_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6052,7 +6178,7 @@ func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoPredefinedState_Identity_Flip.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 3, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 3, 0}
 }
 
 func (x *ProtoPredefinedState_Identity_Flip) GetCid() []byte {
@@ -6081,7 +6207,7 @@ type ProtoPredefinedState_Identity_TxAddr struct {
 func (x *ProtoPredefinedState_Identity_TxAddr) Reset() {
 	*x = ProtoPredefinedState_Identity_TxAddr{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[92]
+		mi := &file_protobuf_models_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6094,7 +6220,7 @@ func (x *ProtoPredefinedState_Identity_TxAddr) String() string {
 func (*ProtoPredefinedState_Identity_TxAddr) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[92]
+	mi := &file_protobuf_models_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6107,7 +6233,7 @@ func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ProtoPredefinedState_Identity_TxAddr.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 3, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 3, 1}
 }
 
 func (x *ProtoPredefinedState_Identity_TxAddr) GetHash() []byte {
@@ -6137,7 +6263,7 @@ type ProtoPredefinedState_Identity_Inviter struct {
 func (x *ProtoPredefinedState_Identity_Inviter) Reset() {
 	*x = ProtoPredefinedState_Identity_Inviter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[93]
+		mi := &file_protobuf_models_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6150,7 +6276,7 @@ func (x *ProtoPredefinedState_Identity_Inviter) String() string {
 func (*ProtoPredefinedState_Identity_Inviter) ProtoMessage() {}
 
 func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[93]
+	mi := &file_protobuf_models_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6163,7 +6289,7 @@ func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProtoPredefinedState_Identity_Inviter.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState_Identity_Inviter) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50, 3, 2}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52, 3, 2}
 }
 
 func (x *ProtoPredefinedState_Identity_Inviter) GetHash() []byte {
@@ -6206,7 +6332,7 @@ type ProtoTxReceipts_ProtoTxReceipt struct {
 func (x *ProtoTxReceipts_ProtoTxReceipt) Reset() {
 	*x = ProtoTxReceipts_ProtoTxReceipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[94]
+		mi := &file_protobuf_models_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6219,7 +6345,7 @@ func (x *ProtoTxReceipts_ProtoTxReceipt) String() string {
 func (*ProtoTxReceipts_ProtoTxReceipt) ProtoMessage() {}
 
 func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[94]
+	mi := &file_protobuf_models_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6232,7 +6358,7 @@ func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceipts_ProtoTxReceipt.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceipts_ProtoTxReceipt) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{55, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57, 0}
 }
 
 func (x *ProtoTxReceipts_ProtoTxReceipt) GetContract() []byte {
@@ -6303,14 +6429,15 @@ type ProtoTxReceipts_ProtoEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Data  [][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Event    string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Data     [][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Contract []byte   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
 func (x *ProtoTxReceipts_ProtoEvent) Reset() {
 	*x = ProtoTxReceipts_ProtoEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[95]
+		mi := &file_protobuf_models_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6323,7 +6450,7 @@ func (x *ProtoTxReceipts_ProtoEvent) String() string {
 func (*ProtoTxReceipts_ProtoEvent) ProtoMessage() {}
 
 func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[95]
+	mi := &file_protobuf_models_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6336,7 +6463,7 @@ func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTxReceipts_ProtoEvent.ProtoReflect.Descriptor instead.
 func (*ProtoTxReceipts_ProtoEvent) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{55, 1}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57, 1}
 }
 
 func (x *ProtoTxReceipts_ProtoEvent) GetEvent() string {
@@ -6353,6 +6480,13 @@ func (x *ProtoTxReceipts_ProtoEvent) GetData() [][]byte {
 	return nil
 }
 
+func (x *ProtoTxReceipts_ProtoEvent) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
 type ProtoDeferredTxs_ProtoDeferredTx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -6369,7 +6503,7 @@ type ProtoDeferredTxs_ProtoDeferredTx struct {
 func (x *ProtoDeferredTxs_ProtoDeferredTx) Reset() {
 	*x = ProtoDeferredTxs_ProtoDeferredTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[96]
+		mi := &file_protobuf_models_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6382,7 +6516,7 @@ func (x *ProtoDeferredTxs_ProtoDeferredTx) String() string {
 func (*ProtoDeferredTxs_ProtoDeferredTx) ProtoMessage() {}
 
 func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[96]
+	mi := &file_protobuf_models_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6395,7 +6529,7 @@ func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeferredTxs_ProtoDeferredTx.ProtoReflect.Descriptor instead.
 func (*ProtoDeferredTxs_ProtoDeferredTx) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{57, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{59, 0}
 }
 
 func (x *ProtoDeferredTxs_ProtoDeferredTx) GetFrom() []byte {
@@ -6452,7 +6586,7 @@ type ProtoUpgradeVotes_ProtoUpgradeVote struct {
 func (x *ProtoUpgradeVotes_ProtoUpgradeVote) Reset() {
 	*x = ProtoUpgradeVotes_ProtoUpgradeVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[97]
+		mi := &file_protobuf_models_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6465,7 +6599,7 @@ func (x *ProtoUpgradeVotes_ProtoUpgradeVote) String() string {
 func (*ProtoUpgradeVotes_ProtoUpgradeVote) ProtoMessage() {}
 
 func (x *ProtoUpgradeVotes_ProtoUpgradeVote) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[97]
+	mi := &file_protobuf_models_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6478,7 +6612,7 @@ func (x *ProtoUpgradeVotes_ProtoUpgradeVote) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoUpgradeVotes_ProtoUpgradeVote.ProtoReflect.Descriptor instead.
 func (*ProtoUpgradeVotes_ProtoUpgradeVote) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{59, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{61, 0}
 }
 
 func (x *ProtoUpgradeVotes_ProtoUpgradeVote) GetVoter() []byte {
@@ -6511,7 +6645,7 @@ type ProtoLotteryIdentitiesDb_Identity struct {
 func (x *ProtoLotteryIdentitiesDb_Identity) Reset() {
 	*x = ProtoLotteryIdentitiesDb_Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[98]
+		mi := &file_protobuf_models_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6524,7 +6658,7 @@ func (x *ProtoLotteryIdentitiesDb_Identity) String() string {
 func (*ProtoLotteryIdentitiesDb_Identity) ProtoMessage() {}
 
 func (x *ProtoLotteryIdentitiesDb_Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[98]
+	mi := &file_protobuf_models_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6537,7 +6671,7 @@ func (x *ProtoLotteryIdentitiesDb_Identity) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProtoLotteryIdentitiesDb_Identity.ProtoReflect.Descriptor instead.
 func (*ProtoLotteryIdentitiesDb_Identity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{60, 0}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{62, 0}
 }
 
 func (x *ProtoLotteryIdentitiesDb_Identity) GetAddress() []byte {
@@ -6848,559 +6982,573 @@ var file_protobuf_models_proto_rawDesc = []byte{
 	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c,
 	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
-	0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b,
-	0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12,
-	0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
-	0x4b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x51, 0x0a, 0x0d,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x6b, 0x73, 0x22, 0x7f, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
 	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64,
-	0x56, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x22,
-	0xb1, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
-	0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x36, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x45, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0x51, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x22, 0xb1, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b,
+	0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x1a, 0x36, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x3b, 0x0a, 0x11, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x75, 0x6c, 0x6c, 0x50, 0x75, 0x73, 0x68, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7b, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x44, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x69, 0x64, 0x56, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x56, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x56, 0x32, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x87, 0x01,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62,
+	0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b,
+	0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02,
+	0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xbf, 0x01, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x08, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x68,
+	0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x71, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x19, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x35,
+	0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75,
+	0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32,
+	0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69,
+	0x64, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
 	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x22, 0x3b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x75, 0x6c, 0x6c,
-	0x50, 0x75, 0x73, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x22, 0x7b, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x69, 0x64, 0x56, 0x32, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x56, 0x32, 0x22, 0x46, 0x0a,
-	0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x44, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44,
-	0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
-	0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22,
-	0x55, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69,
-	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x61, 0x76, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x28, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x1a, 0x42, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x68, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0x71, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
-	0x61, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xc1, 0x08, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46,
+	0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x35,
+	0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05,
+	0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x52, 0x07, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70,
 	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63,
 	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a,
-	0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x45, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63,
-	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xc1, 0x08,
-	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c,
-	0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
-	0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73,
-	0x12, 0x3c, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x49, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64,
-	0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
-	0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36,
-	0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0xec, 0x06, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12,
-	0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59,
+	0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xec, 0x06, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
 	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
-	0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72,
-	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72,
-	0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e, 0x75,
-	0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e,
-	0x75, 0x6d, 0x12, 0x5e, 0x0a, 0x13, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x52, 0x13, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x73,
-	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x13, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x22, 0x86, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x22, 0xbf, 0x15, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x73, 0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x12, 0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
-	0xb4, 0x04, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65,
-	0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14,
+	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
 	0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42,
-	0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65,
-	0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75,
-	0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61,
-	0x72, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x1a, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x55, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0xf2, 0x08, 0x0a, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46,
-	0x6c, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69,
-	0x70, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66,
-	0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c,
+	0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54,
+	0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65,
+	0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x12, 0x5e, 0x0a, 0x13, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x52, 0x13, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x42, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x1a, 0x4d,
+	0x0a, 0x13, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a,
+	0x09, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xbf, 0x15, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72,
+	0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64,
 	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69,
-	0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x65, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65,
-	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a,
+	0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4b,
+	0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xb4, 0x04, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65,
+	0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69,
+	0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67,
+	0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43,
+	0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e,
+	0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x4e, 0x75, 0x6d, 0x1a, 0x2c,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x82, 0x02, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a,
+	0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x1a, 0xf2, 0x08, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69,
+	0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x66, 0x6c,
+	0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72,
+	0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a,
+	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x70,
-	0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0x2c, 0x0a,
-	0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54,
-	0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x96,
-	0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x3a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x61, 0x6c, 0x6c,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x4f,
-	0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22,
-	0x36, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x49, 0x70, 0x66, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x0f,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12,
-	0x42, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x1a, 0x90, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x36, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d,
-	0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe2, 0x01,
-	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54,
-	0x78, 0x73, 0x12, 0x3a, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44,
-	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x1a, 0x91,
-	0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x22, 0x57, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x70,
+	0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x65, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x96, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x1a,
+	0x3a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x4f, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22,
+	0x42, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x49,
+	0x70, 0x66, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0xbc, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x1a, 0x90, 0x02, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x43,
+	0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x61, 0x73, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x52,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x3a, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x52, 0x03, 0x54,
+	0x78, 0x73, 0x1a, 0x91, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x2e,
 	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65,
-	0x73, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f,
-	0x74, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x44, 0x62, 0x12, 0x49, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x44, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a,
-	0xd0, 0x01, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x65,
-	0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x66, 0x6c, 0x69, 0x70, 0x43, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x08, 0x66, 0x6c, 0x69, 0x70, 0x43, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
-	0x62, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x44,
-	0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c,
-	0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x61, 0x73, 0x44, 0x6f,
-	0x6e, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69,
-	0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x18,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x44, 0x62, 0x12, 0x49, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x44, 0x62, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x1a, 0xd0, 0x01, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x6c, 0x69, 0x70, 0x43, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x6c, 0x69, 0x70, 0x43, 0x69, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x17,
+	0x68, 0x61, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x68,
+	0x61, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7415,7 +7563,7 @@ func file_protobuf_models_proto_rawDescGZIP() []byte {
 	return file_protobuf_models_proto_rawDescData
 }
 
-var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
 var file_protobuf_models_proto_goTypes = []interface{}{
 	(*ProtoTransaction)(nil),                              // 0: models.ProtoTransaction
 	(*ProtoBlockHeader)(nil),                              // 1: models.ProtoBlockHeader
@@ -7442,135 +7590,137 @@ var file_protobuf_models_proto_goTypes = []interface{}{
 	(*ProtoGetBlockByHashRequest)(nil),                    // 22: models.ProtoGetBlockByHashRequest
 	(*ProtoGetBlocksRangeRequest)(nil),                    // 23: models.ProtoGetBlocksRangeRequest
 	(*ProtoGetForkBlockRangeRequest)(nil),                 // 24: models.ProtoGetForkBlockRangeRequest
-	(*ProtoFlip)(nil),                                     // 25: models.ProtoFlip
-	(*ProtoFlipKey)(nil),                                  // 26: models.ProtoFlipKey
-	(*ProtoManifest)(nil),                                 // 27: models.ProtoManifest
-	(*ProtoPrivateFlipKeysPackage)(nil),                   // 28: models.ProtoPrivateFlipKeysPackage
-	(*ProtoPullPushHash)(nil),                             // 29: models.ProtoPullPushHash
-	(*ProtoSnapshotManifestDb)(nil),                       // 30: models.ProtoSnapshotManifestDb
-	(*ProtoShortAnswerDb)(nil),                            // 31: models.ProtoShortAnswerDb
-	(*ProtoAnswersDb)(nil),                                // 32: models.ProtoAnswersDb
-	(*ProtoBurntCoins)(nil),                               // 33: models.ProtoBurntCoins
-	(*ProtoSavedTransaction)(nil),                         // 34: models.ProtoSavedTransaction
-	(*ProtoActivityMonitor)(nil),                          // 35: models.ProtoActivityMonitor
-	(*ProtoShortAnswerAttachment)(nil),                    // 36: models.ProtoShortAnswerAttachment
-	(*ProtoLongAnswerAttachment)(nil),                     // 37: models.ProtoLongAnswerAttachment
-	(*ProtoFlipSubmitAttachment)(nil),                     // 38: models.ProtoFlipSubmitAttachment
-	(*ProtoOnlineStatusAttachment)(nil),                   // 39: models.ProtoOnlineStatusAttachment
-	(*ProtoBurnAttachment)(nil),                           // 40: models.ProtoBurnAttachment
-	(*ProtoChangeProfileAttachment)(nil),                  // 41: models.ProtoChangeProfileAttachment
-	(*ProtoDeleteFlipAttachment)(nil),                     // 42: models.ProtoDeleteFlipAttachment
-	(*ProtoStateAccount)(nil),                             // 43: models.ProtoStateAccount
-	(*ProtoStateIdentity)(nil),                            // 44: models.ProtoStateIdentity
-	(*ProtoStateGlobal)(nil),                              // 45: models.ProtoStateGlobal
-	(*ProtoStateApprovedIdentity)(nil),                    // 46: models.ProtoStateApprovedIdentity
-	(*ProtoStateIdentityStatusSwitch)(nil),                // 47: models.ProtoStateIdentityStatusSwitch
-	(*ProtoStateDelegationSwitch)(nil),                    // 48: models.ProtoStateDelegationSwitch
-	(*ProtoStateDelayedPenalties)(nil),                    // 49: models.ProtoStateDelayedPenalties
-	(*ProtoPredefinedState)(nil),                          // 50: models.ProtoPredefinedState
-	(*ProtoCallContractAttachment)(nil),                   // 51: models.ProtoCallContractAttachment
-	(*ProtoDeployContractAttachment)(nil),                 // 52: models.ProtoDeployContractAttachment
-	(*ProtoTerminateContractAttachment)(nil),              // 53: models.ProtoTerminateContractAttachment
-	(*ProtoStoreToIpfsAttachment)(nil),                    // 54: models.ProtoStoreToIpfsAttachment
-	(*ProtoTxReceipts)(nil),                               // 55: models.ProtoTxReceipts
-	(*ProtoTxReceiptIndex)(nil),                           // 56: models.ProtoTxReceiptIndex
-	(*ProtoDeferredTxs)(nil),                              // 57: models.ProtoDeferredTxs
-	(*ProtoSavedEvent)(nil),                               // 58: models.ProtoSavedEvent
-	(*ProtoUpgradeVotes)(nil),                             // 59: models.ProtoUpgradeVotes
-	(*ProtoLotteryIdentitiesDb)(nil),                      // 60: models.ProtoLotteryIdentitiesDb
-	(*ProtoTransaction_Data)(nil),                         // 61: models.ProtoTransaction.Data
-	(*ProtoBlockHeader_Proposed)(nil),                     // 62: models.ProtoBlockHeader.Proposed
-	(*ProtoBlockHeader_Empty)(nil),                        // 63: models.ProtoBlockHeader.Empty
-	(*ProtoBlockProposal_Data)(nil),                       // 64: models.ProtoBlockProposal.Data
-	(*ProtoBlockCert_Signature)(nil),                      // 65: models.ProtoBlockCert.Signature
-	(*ProtoMsgBatch_BatchItem)(nil),                       // 66: models.ProtoMsgBatch.BatchItem
-	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 67: models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 68: models.ProtoSnapshotBlock.KeyValue
-	(*ProtoSnapshotNodes_Node)(nil),                       // 69: models.ProtoSnapshotNodes.Node
-	(*ProtoGossipBlockRange_Block)(nil),                   // 70: models.ProtoGossipBlockRange.Block
-	(*ProtoProposeProof_Data)(nil),                        // 71: models.ProtoProposeProof.Data
-	(*ProtoVote_Data)(nil),                                // 72: models.ProtoVote.Data
-	(*ProtoFlipKey_Data)(nil),                             // 73: models.ProtoFlipKey.Data
-	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 74: models.ProtoPrivateFlipKeysPackage.Data
-	(*ProtoAnswersDb_Answer)(nil),                         // 75: models.ProtoAnswersDb.Answer
-	(*ProtoActivityMonitor_Activity)(nil),                 // 76: models.ProtoActivityMonitor.Activity
-	(*ProtoStateAccount_ProtoContractData)(nil),           // 77: models.ProtoStateAccount.ProtoContractData
-	(*ProtoStateIdentity_Flip)(nil),                       // 78: models.ProtoStateIdentity.Flip
-	(*ProtoStateIdentity_TxAddr)(nil),                     // 79: models.ProtoStateIdentity.TxAddr
-	(*ProtoStateIdentity_Inviter)(nil),                    // 80: models.ProtoStateIdentity.Inviter
-	(*ProtoStateGlobal_EmptyBlocksByShards)(nil),          // 81: models.ProtoStateGlobal.EmptyBlocksByShards
-	(*ProtoStateGlobal_ShardSize)(nil),                    // 82: models.ProtoStateGlobal.ShardSize
-	(*ProtoStateDelegationSwitch_Delegation)(nil),         // 83: models.ProtoStateDelegationSwitch.Delegation
-	(*ProtoPredefinedState_Global)(nil),                   // 84: models.ProtoPredefinedState.Global
-	(*ProtoPredefinedState_StatusSwitch)(nil),             // 85: models.ProtoPredefinedState.StatusSwitch
-	(*ProtoPredefinedState_Account)(nil),                  // 86: models.ProtoPredefinedState.Account
-	(*ProtoPredefinedState_Identity)(nil),                 // 87: models.ProtoPredefinedState.Identity
-	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 88: models.ProtoPredefinedState.ApprovedIdentity
-	(*ProtoPredefinedState_ContractKeyValue)(nil),         // 89: models.ProtoPredefinedState.ContractKeyValue
-	(*ProtoPredefinedState_Account_ContractData)(nil),     // 90: models.ProtoPredefinedState.Account.ContractData
-	(*ProtoPredefinedState_Identity_Flip)(nil),            // 91: models.ProtoPredefinedState.Identity.Flip
-	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 92: models.ProtoPredefinedState.Identity.TxAddr
-	(*ProtoPredefinedState_Identity_Inviter)(nil),         // 93: models.ProtoPredefinedState.Identity.Inviter
-	(*ProtoTxReceipts_ProtoTxReceipt)(nil),                // 94: models.ProtoTxReceipts.ProtoTxReceipt
-	(*ProtoTxReceipts_ProtoEvent)(nil),                    // 95: models.ProtoTxReceipts.ProtoEvent
-	(*ProtoDeferredTxs_ProtoDeferredTx)(nil),              // 96: models.ProtoDeferredTxs.ProtoDeferredTx
-	(*ProtoUpgradeVotes_ProtoUpgradeVote)(nil),            // 97: models.ProtoUpgradeVotes.ProtoUpgradeVote
-	(*ProtoLotteryIdentitiesDb_Identity)(nil),             // 98: models.ProtoLotteryIdentitiesDb.Identity
+	(*ProtoGetStateProofRequest)(nil),                     // 25: models.ProtoGetStateProofRequest
+	(*ProtoStateProof)(nil),                               // 26: models.ProtoStateProof
+	(*ProtoFlip)(nil),                                     // 27: models.ProtoFlip
+	(*ProtoFlipKey)(nil),                                  // 28: models.ProtoFlipKey
+	(*ProtoManifest)(nil),                                 // 29: models.ProtoManifest
+	(*ProtoPrivateFlipKeysPackage)(nil),                   // 30: models.ProtoPrivateFlipKeysPackage
+	(*ProtoPullPushHash)(nil),                             // 31: models.ProtoPullPushHash
+	(*ProtoSnapshotManifestDb)(nil),                       // 32: models.ProtoSnapshotManifestDb
+	(*ProtoShortAnswerDb)(nil),                            // 33: models.ProtoShortAnswerDb
+	(*ProtoAnswersDb)(nil),                                // 34: models.ProtoAnswersDb
+	(*ProtoBurntCoins)(nil),                               // 35: models.ProtoBurntCoins
+	(*ProtoSavedTransaction)(nil),                         // 36: models.ProtoSavedTransaction
+	(*ProtoActivityMonitor)(nil),                          // 37: models.ProtoActivityMonitor
+	(*ProtoShortAnswerAttachment)(nil),                    // 38: models.ProtoShortAnswerAttachment
+	(*ProtoLongAnswerAttachment)(nil),                     // 39: models.ProtoLongAnswerAttachment
+	(*ProtoFlipSubmitAttachment)(nil),                     // 40: models.ProtoFlipSubmitAttachment
+	(*ProtoOnlineStatusAttachment)(nil),                   // 41: models.ProtoOnlineStatusAttachment
+	(*ProtoBurnAttachment)(nil),                           // 42: models.ProtoBurnAttachment
+	(*ProtoChangeProfileAttachment)(nil),                  // 43: models.ProtoChangeProfileAttachment
+	(*ProtoDeleteFlipAttachment)(nil),                     // 44: models.ProtoDeleteFlipAttachment
+	(*ProtoStateAccount)(nil),                             // 45: models.ProtoStateAccount
+	(*ProtoStateIdentity)(nil),                            // 46: models.ProtoStateIdentity
+	(*ProtoStateGlobal)(nil),                              // 47: models.ProtoStateGlobal
+	(*ProtoStateApprovedIdentity)(nil),                    // 48: models.ProtoStateApprovedIdentity
+	(*ProtoStateIdentityStatusSwitch)(nil),                // 49: models.ProtoStateIdentityStatusSwitch
+	(*ProtoStateDelegationSwitch)(nil),                    // 50: models.ProtoStateDelegationSwitch
+	(*ProtoStateDelayedPenalties)(nil),                    // 51: models.ProtoStateDelayedPenalties
+	(*ProtoPredefinedState)(nil),                          // 52: models.ProtoPredefinedState
+	(*ProtoCallContractAttachment)(nil),                   // 53: models.ProtoCallContractAttachment
+	(*ProtoDeployContractAttachment)(nil),                 // 54: models.ProtoDeployContractAttachment
+	(*ProtoTerminateContractAttachment)(nil),              // 55: models.ProtoTerminateContractAttachment
+	(*ProtoStoreToIpfsAttachment)(nil),                    // 56: models.ProtoStoreToIpfsAttachment
+	(*ProtoTxReceipts)(nil),                               // 57: models.ProtoTxReceipts
+	(*ProtoTxReceiptIndex)(nil),                           // 58: models.ProtoTxReceiptIndex
+	(*ProtoDeferredTxs)(nil),                              // 59: models.ProtoDeferredTxs
+	(*ProtoSavedEvent)(nil),                               // 60: models.ProtoSavedEvent
+	(*ProtoUpgradeVotes)(nil),                             // 61: models.ProtoUpgradeVotes
+	(*ProtoLotteryIdentitiesDb)(nil),                      // 62: models.ProtoLotteryIdentitiesDb
+	(*ProtoTransaction_Data)(nil),                         // 63: models.ProtoTransaction.Data
+	(*ProtoBlockHeader_Proposed)(nil),                     // 64: models.ProtoBlockHeader.Proposed
+	(*ProtoBlockHeader_Empty)(nil),                        // 65: models.ProtoBlockHeader.Empty
+	(*ProtoBlockProposal_Data)(nil),                       // 66: models.ProtoBlockProposal.Data
+	(*ProtoBlockCert_Signature)(nil),                      // 67: models.ProtoBlockCert.Signature
+	(*ProtoMsgBatch_BatchItem)(nil),                       // 68: models.ProtoMsgBatch.BatchItem
+	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 69: models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 70: models.ProtoSnapshotBlock.KeyValue
+	(*ProtoSnapshotNodes_Node)(nil),                       // 71: models.ProtoSnapshotNodes.Node
+	(*ProtoGossipBlockRange_Block)(nil),                   // 72: models.ProtoGossipBlockRange.Block
+	(*ProtoProposeProof_Data)(nil),                        // 73: models.ProtoProposeProof.Data
+	(*ProtoVote_Data)(nil),                                // 74: models.ProtoVote.Data
+	(*ProtoFlipKey_Data)(nil),                             // 75: models.ProtoFlipKey.Data
+	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 76: models.ProtoPrivateFlipKeysPackage.Data
+	(*ProtoAnswersDb_Answer)(nil),                         // 77: models.ProtoAnswersDb.Answer
+	(*ProtoActivityMonitor_Activity)(nil),                 // 78: models.ProtoActivityMonitor.Activity
+	(*ProtoStateAccount_ProtoContractData)(nil),           // 79: models.ProtoStateAccount.ProtoContractData
+	(*ProtoStateIdentity_Flip)(nil),                       // 80: models.ProtoStateIdentity.Flip
+	(*ProtoStateIdentity_TxAddr)(nil),                     // 81: models.ProtoStateIdentity.TxAddr
+	(*ProtoStateIdentity_Inviter)(nil),                    // 82: models.ProtoStateIdentity.Inviter
+	(*ProtoStateGlobal_EmptyBlocksByShards)(nil),          // 83: models.ProtoStateGlobal.EmptyBlocksByShards
+	(*ProtoStateGlobal_ShardSize)(nil),                    // 84: models.ProtoStateGlobal.ShardSize
+	(*ProtoStateDelegationSwitch_Delegation)(nil),         // 85: models.ProtoStateDelegationSwitch.Delegation
+	(*ProtoPredefinedState_Global)(nil),                   // 86: models.ProtoPredefinedState.Global
+	(*ProtoPredefinedState_StatusSwitch)(nil),             // 87: models.ProtoPredefinedState.StatusSwitch
+	(*ProtoPredefinedState_Account)(nil),                  // 88: models.ProtoPredefinedState.Account
+	(*ProtoPredefinedState_Identity)(nil),                 // 89: models.ProtoPredefinedState.Identity
+	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 90: models.ProtoPredefinedState.ApprovedIdentity
+	(*ProtoPredefinedState_ContractKeyValue)(nil),         // 91: models.ProtoPredefinedState.ContractKeyValue
+	(*ProtoPredefinedState_Account_ContractData)(nil),     // 92: models.ProtoPredefinedState.Account.ContractData
+	(*ProtoPredefinedState_Identity_Flip)(nil),            // 93: models.ProtoPredefinedState.Identity.Flip
+	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 94: models.ProtoPredefinedState.Identity.TxAddr
+	(*ProtoPredefinedState_Identity_Inviter)(nil),         // 95: models.ProtoPredefinedState.Identity.Inviter
+	(*ProtoTxReceipts_ProtoTxReceipt)(nil),                // 96: models.ProtoTxReceipts.ProtoTxReceipt
+	(*ProtoTxReceipts_ProtoEvent)(nil),                    // 97: models.ProtoTxReceipts.ProtoEvent
+	(*ProtoDeferredTxs_ProtoDeferredTx)(nil),              // 98: models.ProtoDeferredTxs.ProtoDeferredTx
+	(*ProtoUpgradeVotes_ProtoUpgradeVote)(nil),            // 99: models.ProtoUpgradeVotes.ProtoUpgradeVote
+	(*ProtoLotteryIdentitiesDb_Identity)(nil),             // 100: models.ProtoLotteryIdentitiesDb.Identity
 }
 var file_protobuf_models_proto_depIdxs = []int32{
-	61, // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
-	62, // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
-	63, // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
-	0,  // 3: models.ProtoBlockBody.transactions:type_name -> models.ProtoTransaction
-	1,  // 4: models.ProtoBlock.header:type_name -> models.ProtoBlockHeader
-	2,  // 5: models.ProtoBlock.body:type_name -> models.ProtoBlockBody
-	64, // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
-	65, // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
-	66, // 8: models.ProtoMsgBatch.data:type_name -> models.ProtoMsgBatch.BatchItem
-	67, // 9: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	68, // 10: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
-	69, // 11: models.ProtoSnapshotNodes.nodes:type_name -> models.ProtoSnapshotNodes.Node
-	70, // 12: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
-	71, // 13: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
-	72, // 14: models.ProtoVote.data:type_name -> models.ProtoVote.Data
-	0,  // 15: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
-	73, // 16: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
-	74, // 17: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
-	75, // 18: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
-	0,  // 19: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
-	76, // 20: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
-	77, // 21: models.ProtoStateAccount.contractData:type_name -> models.ProtoStateAccount.ProtoContractData
-	78, // 22: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
-	79, // 23: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
-	80, // 24: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.Inviter
-	81, // 25: models.ProtoStateGlobal.emptyBlocksByShards:type_name -> models.ProtoStateGlobal.EmptyBlocksByShards
-	82, // 26: models.ProtoStateGlobal.shardSizes:type_name -> models.ProtoStateGlobal.ShardSize
-	83, // 27: models.ProtoStateDelegationSwitch.delegations:type_name -> models.ProtoStateDelegationSwitch.Delegation
-	84, // 28: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
-	85, // 29: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
-	86, // 30: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
-	87, // 31: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
-	88, // 32: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
-	89, // 33: models.ProtoPredefinedState.contractValues:type_name -> models.ProtoPredefinedState.ContractKeyValue
-	94, // 34: models.ProtoTxReceipts.receipts:type_name -> models.ProtoTxReceipts.ProtoTxReceipt
-	96, // 35: models.ProtoDeferredTxs.Txs:type_name -> models.ProtoDeferredTxs.ProtoDeferredTx
-	97, // 36: models.ProtoUpgradeVotes.votes:type_name -> models.ProtoUpgradeVotes.ProtoUpgradeVote
-	98, // 37: models.ProtoLotteryIdentitiesDb.identities:type_name -> models.ProtoLotteryIdentitiesDb.Identity
-	1,  // 38: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
-	2,  // 39: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
-	1,  // 40: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
-	6,  // 41: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
-	16, // 42: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
-	90, // 43: models.ProtoPredefinedState.Account.contractData:type_name -> models.ProtoPredefinedState.Account.ContractData
-	91, // 44: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
-	92, // 45: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
-	93, // 46: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.Inviter
-	95, // 47: models.ProtoTxReceipts.ProtoTxReceipt.events:type_name -> models.ProtoTxReceipts.ProtoEvent
-	48, // [48:48] is the sub-list for method output_type
-	48, // [48:48] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	63,  // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
+	64,  // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
+	65,  // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
+	0,   // 3: models.ProtoBlockBody.transactions:type_name -> models.ProtoTransaction
+	1,   // 4: models.ProtoBlock.header:type_name -> models.ProtoBlockHeader
+	2,   // 5: models.ProtoBlock.body:type_name -> models.ProtoBlockBody
+	66,  // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
+	67,  // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
+	68,  // 8: models.ProtoMsgBatch.data:type_name -> models.ProtoMsgBatch.BatchItem
+	69,  // 9: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	70,  // 10: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
+	71,  // 11: models.ProtoSnapshotNodes.nodes:type_name -> models.ProtoSnapshotNodes.Node
+	72,  // 12: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
+	73,  // 13: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
+	74,  // 14: models.ProtoVote.data:type_name -> models.ProtoVote.Data
+	0,   // 15: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
+	75,  // 16: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
+	76,  // 17: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
+	77,  // 18: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
+	0,   // 19: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
+	78,  // 20: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
+	79,  // 21: models.ProtoStateAccount.contractData:type_name -> models.ProtoStateAccount.ProtoContractData
+	80,  // 22: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
+	81,  // 23: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
+	82,  // 24: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.Inviter
+	83,  // 25: models.ProtoStateGlobal.emptyBlocksByShards:type_name -> models.ProtoStateGlobal.EmptyBlocksByShards
+	84,  // 26: models.ProtoStateGlobal.shardSizes:type_name -> models.ProtoStateGlobal.ShardSize
+	85,  // 27: models.ProtoStateDelegationSwitch.delegations:type_name -> models.ProtoStateDelegationSwitch.Delegation
+	86,  // 28: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
+	87,  // 29: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
+	88,  // 30: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
+	89,  // 31: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
+	90,  // 32: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
+	91,  // 33: models.ProtoPredefinedState.contractValues:type_name -> models.ProtoPredefinedState.ContractKeyValue
+	96,  // 34: models.ProtoTxReceipts.receipts:type_name -> models.ProtoTxReceipts.ProtoTxReceipt
+	98,  // 35: models.ProtoDeferredTxs.Txs:type_name -> models.ProtoDeferredTxs.ProtoDeferredTx
+	99,  // 36: models.ProtoUpgradeVotes.votes:type_name -> models.ProtoUpgradeVotes.ProtoUpgradeVote
+	100, // 37: models.ProtoLotteryIdentitiesDb.identities:type_name -> models.ProtoLotteryIdentitiesDb.Identity
+	1,   // 38: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
+	2,   // 39: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
+	1,   // 40: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
+	6,   // 41: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
+	16,  // 42: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
+	92,  // 43: models.ProtoPredefinedState.Account.contractData:type_name -> models.ProtoPredefinedState.Account.ContractData
+	93,  // 44: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
+	94,  // 45: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
+	95,  // 46: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.Inviter
+	97,  // 47: models.ProtoTxReceipts.ProtoTxReceipt.events:type_name -> models.ProtoTxReceipts.ProtoEvent
+	48,  // [48:48] is the sub-list for method output_type
+	48,  // [48:48] is the sub-list for method input_type
+	48,  // [48:48] is the sub-list for extension type_name
+	48,  // [48:48] is the sub-list for extension extendee
+	0,   // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_models_proto_init() }
@@ -7880,7 +8030,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlip); i {
+			switch v := v.(*ProtoGetStateProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7892,7 +8042,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipKey); i {
+			switch v := v.(*ProtoStateProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7904,7 +8054,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoManifest); i {
+			switch v := v.(*ProtoFlip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7916,7 +8066,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPrivateFlipKeysPackage); i {
+			switch v := v.(*ProtoFlipKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7928,7 +8078,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPullPushHash); i {
+			switch v := v.(*ProtoManifest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7940,7 +8090,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotManifestDb); i {
+			switch v := v.(*ProtoPrivateFlipKeysPackage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7952,7 +8102,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoShortAnswerDb); i {
+			switch v := v.(*ProtoPullPushHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7964,7 +8114,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoAnswersDb); i {
+			switch v := v.(*ProtoSnapshotManifestDb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7976,7 +8126,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBurntCoins); i {
+			switch v := v.(*ProtoShortAnswerDb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7988,7 +8138,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSavedTransaction); i {
+			switch v := v.(*ProtoAnswersDb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8000,7 +8150,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoActivityMonitor); i {
+			switch v := v.(*ProtoBurntCoins); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8012,7 +8162,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoShortAnswerAttachment); i {
+			switch v := v.(*ProtoSavedTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8024,7 +8174,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoLongAnswerAttachment); i {
+			switch v := v.(*ProtoActivityMonitor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8036,7 +8186,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipSubmitAttachment); i {
+			switch v := v.(*ProtoShortAnswerAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8048,7 +8198,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoOnlineStatusAttachment); i {
+			switch v := v.(*ProtoLongAnswerAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8060,7 +8210,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBurnAttachment); i {
+			switch v := v.(*ProtoFlipSubmitAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8072,7 +8222,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoChangeProfileAttachment); i {
+			switch v := v.(*ProtoOnlineStatusAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8084,7 +8234,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeleteFlipAttachment); i {
+			switch v := v.(*ProtoBurnAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8096,7 +8246,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateAccount); i {
+			switch v := v.(*ProtoChangeProfileAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8108,7 +8258,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity); i {
+			switch v := v.(*ProtoDeleteFlipAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8120,7 +8270,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateGlobal); i {
+			switch v := v.(*ProtoStateAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8132,7 +8282,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateApprovedIdentity); i {
+			switch v := v.(*ProtoStateIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8144,7 +8294,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
+			switch v := v.(*ProtoStateGlobal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8156,7 +8306,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateDelegationSwitch); i {
+			switch v := v.(*ProtoStateApprovedIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8168,7 +8318,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateDelayedPenalties); i {
+			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8180,7 +8330,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState); i {
+			switch v := v.(*ProtoStateDelegationSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8192,7 +8342,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoCallContractAttachment); i {
+			switch v := v.(*ProtoStateDelayedPenalties); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8204,7 +8354,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeployContractAttachment); i {
+			switch v := v.(*ProtoPredefinedState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8216,7 +8366,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTerminateContractAttachment); i {
+			switch v := v.(*ProtoCallContractAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8228,7 +8378,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStoreToIpfsAttachment); i {
+			switch v := v.(*ProtoDeployContractAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8240,7 +8390,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceipts); i {
+			switch v := v.(*ProtoTerminateContractAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8252,7 +8402,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceiptIndex); i {
+			switch v := v.(*ProtoStoreToIpfsAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8264,7 +8414,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeferredTxs); i {
+			switch v := v.(*ProtoTxReceipts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8276,7 +8426,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSavedEvent); i {
+			switch v := v.(*ProtoTxReceiptIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8288,7 +8438,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoUpgradeVotes); i {
+			switch v := v.(*ProtoDeferredTxs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8300,7 +8450,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoLotteryIdentitiesDb); i {
+			switch v := v.(*ProtoSavedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8312,7 +8462,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTransaction_Data); i {
+			switch v := v.(*ProtoUpgradeVotes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8324,7 +8474,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader_Proposed); i {
+			switch v := v.(*ProtoLotteryIdentitiesDb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8336,7 +8486,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader_Empty); i {
+			switch v := v.(*ProtoTransaction_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8348,7 +8498,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockProposal_Data); i {
+			switch v := v.(*ProtoBlockHeader_Proposed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8360,7 +8510,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockCert_Signature); i {
+			switch v := v.(*ProtoBlockHeader_Empty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8372,7 +8522,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoMsgBatch_BatchItem); i {
+			switch v := v.(*ProtoBlockProposal_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8384,7 +8534,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
+			switch v := v.(*ProtoBlockCert_Signature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8396,7 +8546,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
+			switch v := v.(*ProtoMsgBatch_BatchItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8408,7 +8558,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotNodes_Node); i {
+			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8420,7 +8570,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGossipBlockRange_Block); i {
+			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8432,7 +8582,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoProposeProof_Data); i {
+			switch v := v.(*ProtoSnapshotNodes_Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8444,7 +8594,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoVote_Data); i {
+			switch v := v.(*ProtoGossipBlockRange_Block); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8456,7 +8606,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipKey_Data); i {
+			switch v := v.(*ProtoProposeProof_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8468,7 +8618,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
+			switch v := v.(*ProtoVote_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8480,7 +8630,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoAnswersDb_Answer); i {
+			switch v := v.(*ProtoFlipKey_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8492,7 +8642,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoActivityMonitor_Activity); i {
+			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8504,7 +8654,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateAccount_ProtoContractData); i {
+			switch v := v.(*ProtoAnswersDb_Answer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8516,7 +8666,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_Flip); i {
+			switch v := v.(*ProtoActivityMonitor_Activity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8528,7 +8678,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_TxAddr); i {
+			switch v := v.(*ProtoStateAccount_ProtoContractData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8540,7 +8690,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_Inviter); i {
+			switch v := v.(*ProtoStateIdentity_Flip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8552,7 +8702,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateGlobal_EmptyBlocksByShards); i {
+			switch v := v.(*ProtoStateIdentity_TxAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8564,7 +8714,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateGlobal_ShardSize); i {
+			switch v := v.(*ProtoStateIdentity_Inviter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8576,7 +8726,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateDelegationSwitch_Delegation); i {
+			switch v := v.(*ProtoStateGlobal_EmptyBlocksByShards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8588,7 +8738,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Global); i {
+			switch v := v.(*ProtoStateGlobal_ShardSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8600,7 +8750,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
+			switch v := v.(*ProtoStateDelegationSwitch_Delegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8612,7 +8762,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Account); i {
+			switch v := v.(*ProtoPredefinedState_Global); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8624,7 +8774,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity); i {
+			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8636,7 +8786,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
+			switch v := v.(*ProtoPredefinedState_Account); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8648,7 +8798,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_ContractKeyValue); i {
+			switch v := v.(*ProtoPredefinedState_Identity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8660,7 +8810,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Account_ContractData); i {
+			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8672,7 +8822,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
+			switch v := v.(*ProtoPredefinedState_ContractKeyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8684,7 +8834,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_TxAddr); i {
+			switch v := v.(*ProtoPredefinedState_Account_ContractData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8696,7 +8846,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_Inviter); i {
+			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8708,7 +8858,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceipts_ProtoTxReceipt); i {
+			switch v := v.(*ProtoPredefinedState_Identity_TxAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8720,7 +8870,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceipts_ProtoEvent); i {
+			switch v := v.(*ProtoPredefinedState_Identity_Inviter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8732,7 +8882,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeferredTxs_ProtoDeferredTx); i {
+			switch v := v.(*ProtoTxReceipts_ProtoTxReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8744,7 +8894,7 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoUpgradeVotes_ProtoUpgradeVote); i {
+			switch v := v.(*ProtoTxReceipts_ProtoEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8756,6 +8906,30 @@ func file_protobuf_models_proto_init() {
 			}
 		}
 		file_protobuf_models_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoDeferredTxs_ProtoDeferredTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoUpgradeVotes_ProtoUpgradeVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProtoLotteryIdentitiesDb_Identity); i {
 			case 0:
 				return &v.state
@@ -8774,7 +8948,7 @@ func file_protobuf_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   101,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -69,6 +69,7 @@ type Downloader struct {
 	keyStore             *keystore.KeyStore
 	subManager           *subscriptions.Manager
 	upgrader             *upgrade.Upgrader
+	lightClient          *LightClient
 }
 
 func (d *Downloader) IsSyncing() bool {
@@ -113,9 +114,26 @@ func NewDownloader(
 		subManager:           subManager,
 		keyStore:             keyStore,
 		upgrader:             upgrader,
+		lightClient:          NewLightClient(pm, chain),
 	}
 }
 
+func (d *Downloader) IsLightMode() bool {
+	return d.cfg.Sync.LightMode
+}
+
+func (d *Downloader) LightClient() *LightClient {
+	return d.lightClient
+}
+
+// head returns the last header of the synchronized chain, in light mode it is the last header followed by light sync
+func (d *Downloader) head() *types.Header {
+	if d.IsLightMode() && d.chain.PreliminaryHead != nil {
+		return d.chain.PreliminaryHead
+	}
+	return d.chain.Head
+}
+
 func getTopHeight(heights map[peer.ID]uint64) uint64 {
 	max := uint64(0)
 	for _, value := range heights {
@@ -150,7 +168,7 @@ func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
 			return errors.New("all connected peers are in fork")
 		}
 
-		head := d.chain.Head
+		head := d.head()
 		d.top = getTopHeight(knownHeights)
 		if head.Height() >= d.top {
 			d.log.Info(fmt.Sprintf("Node is synchronized"))
@@ -161,6 +179,10 @@ func (d *Downloader) SyncBlockchain(forkResolver ForkResolver) error {
 			defer d.stopSync()
 		}
 		d.Load()
+		if d.IsLightMode() && d.head().Height() == head.Height() {
+			d.log.Info("Light sync is waiting for certified headers")
+			return nil
+		}
 	}
 }
 
@@ -281,6 +303,11 @@ func (d *Downloader) ClearPotentialForks() {
 
 func (d *Downloader) createBlockApplier() (loader blockApplier, toHeight uint64) {
 
+	if d.IsLightMode() {
+		d.log.Info("Light sync will be used")
+		return NewLightSync(d.pm, d.log, d.chain, d.appState, d.potentialForkedPeers, d.bus, d.upgrader), d.top
+	}
+
 	canUseFastSync := d.cfg.Sync.FastSync
 
 	if d.top-d.chain.Head.Height() < d.cfg.Sync.ForceFullSync {
@@ -45,6 +45,8 @@ type fastSync struct {
 	subManager           *subscriptions.Manager
 	upgrader             *upgrade.Upgrader
 	prevConfig           *config.ConsensusConf
+	// light is set when headers are consumed by light sync, so neither block bodies nor snapshot are loaded
+	light bool
 
 	pubKeyToAddrCache map[string]common.Address
 }
@@ -168,7 +170,7 @@ func (fs *fastSync) applyDeferredBlocks() (uint64, error) {
 		if !b.Cert.Empty() {
 			fs.chain.WriteCertificate(b.Header.Hash(), b.Cert, true)
 		}
-		if b.Header.ProposedHeader == nil || len(b.Header.ProposedHeader.TxBloom) == 0 {
+		if fs.light || b.Header.ProposedHeader == nil || len(b.Header.ProposedHeader.TxBloom) == 0 {
 			continue
 		}
 		bloom, err := common.NewSerializableBFFromData(b.Header.ProposedHeader.TxBloom)
@@ -236,7 +238,7 @@ func (fs *fastSync) GetTxReceipts(receiptCid []byte) (types.TxReceipts, error) {
 }
 
 func (fs *fastSync) processBatch(batch *batch, attemptNum int) error {
-	if fs.manifest == nil {
+	if fs.manifest == nil && !fs.light {
 		panic("manifest is required")
 	}
 	fs.log.Info("Start process batch", "from", batch.from, "to", batch.to)
@@ -39,7 +39,8 @@ var IdenaProtocol = core.ProtocolID(IdenaProtocolPath + "/1.1.0")
 const MempoolSyncDelay = time.Second * 5
 
 var (
-	batchId = uint32(1)
+	batchId             = uint32(1)
+	stateProofRequestId = uint32(1)
 )
 
 type IdenaGossipHandler struct {
@@ -60,10 +61,14 @@ type IdenaGossipHandler struct {
 	flipKeyChan         chan *events.NewFlipKeyEvent
 	flipKeysPackageChan chan *events.NewFlipKeysPackageEvent
 	incomeBatches       *sync.Map
+	pendingStateProofs  *sync.Map
+	stateProofTasks     chan *stateProofTask
 	batchedLock         sync.Mutex
 	bus                 eventbus.Bus
 	wrongTime           bool
 	appVersion          string
+	// light is set for light nodes which have no state and ignore consensus and mempool messages
+	light bool
 
 	log              log.Logger
 	throttlingLogger log.ThrottlingLogger
@@ -92,6 +97,8 @@ func NewIdenaGossipHandler(host core.Host, pubsub *pubsub.PubSub, cfg config.P2P
 		peers:               newPeerSet(),
 		incomeBlocks:        make(chan *types.Block, 1000),
 		incomeBatches:       &sync.Map{},
+		pendingStateProofs:  &sync.Map{},
+		stateProofTasks:     make(chan *stateProofTask, stateProofQueueSize),
 		proposals:           proposals,
 		votes:               votes,
 		pushPullManager:     NewPushPullManager(),
@@ -103,6 +110,7 @@ func NewIdenaGossipHandler(host core.Host, pubsub *pubsub.PubSub, cfg config.P2P
 		bus:                 bus,
 		flipKeyPool:         mempool.NewAsyncKeysPool(flipKeyPool),
 		appVersion:          appVersion,
+		light:               chain.Config().Sync.LightMode,
 		log:                 logger,
 		throttlingLogger:    throttlingLogger,
 		pendingPeers:        make(map[peer.ID]struct{}),
@@ -173,6 +181,9 @@ func (h *IdenaGossipHandler) Start() {
 	go h.checkTime()
 	go h.background()
 	go h.watchShardSubscription()
+	for i := 0; i < stateProofWorkers; i++ {
+		go h.stateProofLoop()
+	}
 }
 
 func (h *IdenaGossipHandler) background() {
@@ -201,6 +212,9 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 	if err != nil {
 		return err
 	}
+	if h.light && !handledInLightMode(msg.Code) {
+		return nil
+	}
 	switch msg.Code {
 	case BlocksRange:
 		var response blockRange
@@ -462,6 +476,25 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		}
 		p.shardId = upd.ShardId
 		h.connManager.UpdatePeerShardId(p.id, upd.ShardId)
+	case GetStateProof:
+		query := new(models.ProtoGetStateProofRequest)
+		if err := proto.Unmarshal(msg.Payload, query); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if h.light || !p.allowStateProofRequest(time.Now()) || !h.enqueueStateProofTask(p, query) {
+			h.sendStateProof(p, query.RequestId, nil)
+		}
+	case StateProof:
+		response := new(models.ProtoStateProof)
+		if err := proto.Unmarshal(msg.Payload, response); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if pending, ok := h.pendingStateProofs.Load(stateProofRequestKey{p.id, response.RequestId}); ok {
+			select {
+			case pending.(chan []byte) <- response.Proof:
+			default:
+			}
+		}
 	case Disconnect:
 		dc := new(disconnect)
 		if err := dc.FromBytes(msg.Payload); err != nil {
@@ -700,6 +733,56 @@ func (h *IdenaGossipHandler) provideForkBlocks(p *protoPeer, batchId uint32, blo
 	}, common.MultiShard, false)
 }
 
+type stateProofTask struct {
+	peer  *protoPeer
+	query *models.ProtoGetStateProofRequest
+}
+
+func (h *IdenaGossipHandler) enqueueStateProofTask(p *protoPeer, query *models.ProtoGetStateProofRequest) bool {
+	select {
+	case h.stateProofTasks <- &stateProofTask{p, query}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *IdenaGossipHandler) stateProofLoop() {
+	for task := range h.stateProofTasks {
+		if task.peer.closed {
+			continue
+		}
+		h.provideStateProof(task.peer, task.query)
+	}
+}
+
+func (h *IdenaGossipHandler) provideStateProof(p *protoPeer, query *models.ProtoGetStateProofRequest) {
+	addr := common.BytesToAddress(query.Address)
+	var proof []byte
+	var err error
+	switch StateProofType(query.Type) {
+	case AccountStateProof:
+		proof, err = h.bcn.GetAccountWithProof(query.Height, addr)
+	case IdentityStateProof:
+		proof, err = h.bcn.GetIdentityWithProof(query.Height, addr)
+	case ApprovedIdentityStateProof:
+		proof, err = h.bcn.GetApprovedIdentityWithProof(query.Height, addr)
+	default:
+		err = errors.New("unknown state proof type")
+	}
+	if err != nil {
+		p.log.Debug("Failed to provide state proof", "height", query.Height, "err", err)
+	}
+	h.sendStateProof(p, query.RequestId, proof)
+}
+
+func (h *IdenaGossipHandler) sendStateProof(p *protoPeer, requestId uint32, proof []byte) {
+	p.sendMsg(StateProof, &models.ProtoStateProof{
+		RequestId: requestId,
+		Proof:     proof,
+	}, common.MultiShard, false)
+}
+
 func (h *IdenaGossipHandler) runListening(peer *protoPeer) {
 	defer h.unregisterPeer(peer.id)
 	for {
@@ -810,6 +893,42 @@ func (h *IdenaGossipHandler) GetForkBlockRange(peerId peer.ID, ownBlocks []commo
 	return b, nil
 }
 
+type stateProofRequestKey struct {
+	peerId    peer.ID
+	requestId uint32
+}
+
+// GetStateProof requests the value with proof of the given type for the address at the given height from the peer
+// and waits for the response, an empty proof means the peer doesn't have the value or the state of the height
+func (h *IdenaGossipHandler) GetStateProof(peerId peer.ID, height uint64, proofType StateProofType, addr common.Address) ([]byte, error) {
+	p := h.peers.Peer(peerId)
+	if p == nil {
+		return nil, errors.New("peer is not found")
+	}
+	key := stateProofRequestKey{peerId, atomic.AddUint32(&stateProofRequestId, 1)}
+	response := make(chan []byte, 1)
+	h.pendingStateProofs.Store(key, response)
+	defer h.pendingStateProofs.Delete(key)
+	p.sendMsg(GetStateProof, &models.ProtoGetStateProofRequest{
+		RequestId: key.requestId,
+		Height:    height,
+		Type:      uint32(proofType),
+		Address:   addr.Bytes(),
+	}, common.MultiShard, false)
+
+	timeout := time.NewTimer(stateProofTimeout)
+	defer timeout.Stop()
+	select {
+	case proof := <-response:
+		if len(proof) == 0 {
+			return nil, errors.New("state proof is not found")
+		}
+		return proof, nil
+	case <-timeout.C:
+		return nil, errors.New("state proof request timeout")
+	}
+}
+
 func (h *IdenaGossipHandler) ProposeProof(proposal *types.ProofProposal) {
 	hash := pushPullHash{
 		Type: pushProof,
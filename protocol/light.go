@@ -0,0 +1,148 @@
+package protocol
+
+import (
+	"github.com/deckarep/golang-set"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/core/upgrade"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const (
+	LightSyncBatchSize = 1000
+	stateProofTimeout  = time.Second * 10
+
+	// state proofs are built by stateProofWorkers off peer read loops, every peer may request
+	// maxStateProofRequests proofs per stateProofRequestsWindow, excess requests get empty responses
+	stateProofWorkers        = 2
+	stateProofQueueSize      = 64
+	maxStateProofRequests    = 10
+	stateProofRequestsWindow = time.Second
+)
+
+type StateProofType uint32
+
+const (
+	AccountStateProof  StateProofType = 1
+	IdentityStateProof StateProofType = 2
+	// ApprovedIdentityStateProof is verified against the identity root, full nodes don't provide it
+	// if the identity is not approved since the absence of a key can't be proven
+	ApprovedIdentityStateProof StateProofType = 3
+)
+
+// lightSync follows block headers and certificates only. Certificates are validated against the identity state
+// which is built from identity state diffs, neither block bodies nor state snapshots are loaded.
+// The last certified header is kept as the preliminary head of the chain.
+type lightSync struct {
+	*fastSync
+}
+
+func NewLightSync(pm *IdenaGossipHandler, log log.Logger,
+	chain *blockchain.Blockchain,
+	appState *appstate.AppState,
+	potentialForkedPeers mapset.Set,
+	bus eventbus.Bus,
+	upgrader *upgrade.Upgrader) *lightSync {
+
+	return &lightSync{
+		fastSync: &fastSync{
+			appState:             appState,
+			log:                  log,
+			potentialForkedPeers: potentialForkedPeers,
+			chain:                chain,
+			batches:              make(chan *batch, 10),
+			pm:                   pm,
+			isSyncing:            true,
+			bus:                  bus,
+			upgrader:             upgrader,
+			light:                true,
+			pubKeyToAddrCache:    map[string]common.Address{},
+		},
+	}
+}
+
+func (ls *lightSync) batchSize() uint64 {
+	return LightSyncBatchSize
+}
+
+func (ls *lightSync) postConsuming() error {
+	if ls.chain.PreliminaryHead != nil {
+		ls.log.Info("Light sync batches have been applied", "head", ls.chain.PreliminaryHead.Height())
+	}
+	return nil
+}
+
+// handledInLightMode reports whether the message is handled by a light node, other messages require the state
+// or take part in consensus and are ignored
+func handledInLightMode(code uint64) bool {
+	switch code {
+	case BlocksRange, UpdateShardId, GetStateProof, StateProof, Disconnect:
+		return true
+	}
+	return false
+}
+
+type stateProofPeers interface {
+	GetKnownHeights() map[peer.ID]uint64
+	GetStateProof(peerId peer.ID, height uint64, proofType StateProofType, addr common.Address) ([]byte, error)
+	BanPeer(peerId peer.ID, reason error)
+}
+
+// LightClient requests state data from full peers and verifies it against state roots of the followed headers
+type LightClient struct {
+	pm    stateProofPeers
+	chain *blockchain.Blockchain
+	log   log.Logger
+}
+
+func NewLightClient(pm stateProofPeers, chain *blockchain.Blockchain) *LightClient {
+	return &LightClient{
+		pm:    pm,
+		chain: chain,
+		log:   log.New("component", "light client"),
+	}
+}
+
+// GetStateProof requests the value with proof of the given type for the address at the given height,
+// peers are asked one by one until a proof matching the state or identity root of the block is received
+func (lc *LightClient) GetStateProof(height uint64, proofType StateProofType, addr common.Address) ([]byte, error) {
+	header := lc.chain.GetBlockHeaderByHeight(height)
+	if header == nil {
+		return nil, errors.Errorf("block %v is not found", height)
+	}
+	var key []byte
+	root := header.Root()
+	switch proofType {
+	case AccountStateProof:
+		key = state.StateDbKeys.AddressKey(addr)
+	case IdentityStateProof:
+		key = state.StateDbKeys.IdentityKey(addr)
+	case ApprovedIdentityStateProof:
+		key = state.StateDbKeys.IdentityKey(addr)
+		root = header.IdentityRoot()
+	default:
+		return nil, errors.New("unknown state proof type")
+	}
+	for peerId, peerHeight := range lc.pm.GetKnownHeights() {
+		if peerHeight < height {
+			continue
+		}
+		proof, err := lc.pm.GetStateProof(peerId, height, proofType, addr)
+		if err != nil {
+			lc.log.Debug("Failed to get state proof", "peer", peerId, "err", err)
+			continue
+		}
+		if _, err := state.VerifyValueWithProof(root, key, proof); err != nil {
+			lc.pm.BanPeer(peerId, errors.Wrap(err, "invalid state proof"))
+			continue
+		}
+		return proof, nil
+	}
+	return nil, errors.New("state proof is not received from peers")
+}
@@ -0,0 +1,163 @@
+package protocol
+
+import (
+	"github.com/deckarep/golang-set"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/upgrade"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+	"testing"
+	"time"
+)
+
+func TestProtoPeer_allowStateProofRequest(t *testing.T) {
+	p := &protoPeer{}
+	now := time.Now()
+	for i := 0; i < maxStateProofRequests; i++ {
+		require.True(t, p.allowStateProofRequest(now))
+	}
+	require.False(t, p.allowStateProofRequest(now))
+	require.False(t, p.allowStateProofRequest(now.Add(stateProofRequestsWindow/2)))
+	require.True(t, p.allowStateProofRequest(now.Add(stateProofRequestsWindow)))
+}
+
+func Test_handledInLightMode(t *testing.T) {
+	require.True(t, handledInLightMode(BlocksRange))
+	require.True(t, handledInLightMode(StateProof))
+	require.False(t, handledInLightMode(NewTx))
+	require.False(t, handledInLightMode(Vote))
+	require.False(t, handledInLightMode(GetBlocksRange))
+}
+
+func TestLightSync_followHeaders(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := blockchain.NewCustomTestBlockchain(5, 3, key)
+	lightChain, lightAppState := blockchain.NewCustomTestBlockchain(0, 0, key)
+	require.Equal(t, chain.GenesisInfo().Genesis.Hash(), lightChain.GenesisInfo().Genesis.Hash())
+
+	upgrader := upgrade.NewUpgrader(lightChain.Config(), lightAppState, db.NewMemDB())
+	ls := NewLightSync(nil, log.New(), lightChain.Blockchain, lightAppState, mapset.NewSet(), eventbus.New(), upgrader)
+	from, err := ls.preConsuming(lightChain.Head)
+	require.NoError(t, err)
+	require.Equal(t, lightChain.Head.Height()+1, from)
+
+	getBlock := func(height uint64) *block {
+		header := chain.GetBlockHeaderByHeight(height)
+		return &block{
+			Header:       header,
+			Cert:         chain.GetCertificate(header.Hash()),
+			IdentityDiff: chain.GetIdentityDiff(height),
+		}
+	}
+
+	require.Error(t, ls.validateHeader(getBlock(from+1)))
+	forged := getBlock(from)
+	forged.Cert = getBlock(from + 1).Cert
+	require.Error(t, ls.validateHeader(forged))
+
+	for height := from; height <= chain.Head.Height(); height++ {
+		b := getBlock(height)
+		require.NoError(t, ls.validateHeader(b))
+		ls.deferredHeaders = append(ls.deferredHeaders, blockPeer{block: *b})
+		if !b.Cert.Empty() {
+			_, err := ls.applyDeferredBlocks()
+			require.NoError(t, err)
+		}
+	}
+	require.Empty(t, ls.deferredHeaders)
+
+	require.Equal(t, chain.Head.Hash(), lightChain.PreliminaryHead.Hash())
+	require.Equal(t, chain.GetBlockHeaderByHeight(4).Hash(), lightChain.GetBlockHeaderByHeight(4).Hash())
+	require.NotNil(t, lightChain.GetCertificate(chain.Head.Hash()))
+	require.Equal(t, lightChain.GenesisInfo().Genesis.Height(), lightChain.Head.Height())
+}
+
+type testStateProofPeers struct {
+	heights map[peer.ID]uint64
+	proofs  map[peer.ID][]byte
+	banned  []peer.ID
+}
+
+func (peers *testStateProofPeers) GetKnownHeights() map[peer.ID]uint64 {
+	return peers.heights
+}
+
+func (peers *testStateProofPeers) GetStateProof(peerId peer.ID, height uint64, proofType StateProofType, addr common.Address) ([]byte, error) {
+	proof, ok := peers.proofs[peerId]
+	if !ok {
+		return nil, errors.New("state proof is not found")
+	}
+	return proof, nil
+}
+
+func (peers *testStateProofPeers) BanPeer(peerId peer.ID, reason error) {
+	peers.banned = append(peers.banned, peerId)
+}
+
+func TestLightClient_GetStateProof(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, _ := blockchain.NewCustomTestBlockchain(5, 0, key)
+	height := chain.Head.Height()
+
+	accountProof, err := chain.GetAccountWithProof(height, addr)
+	require.NoError(t, err)
+	identityProof, err := chain.GetIdentityWithProof(height, addr)
+	require.NoError(t, err)
+
+	peers := &testStateProofPeers{}
+	lc := NewLightClient(peers, chain.Blockchain)
+
+	_, err = lc.GetStateProof(height, AccountStateProof, addr)
+	require.Error(t, err)
+
+	_, err = lc.GetStateProof(height+1, AccountStateProof, addr)
+	require.Error(t, err)
+
+	peers.heights = map[peer.ID]uint64{"invalid": height, "missing": height}
+	peers.proofs = map[peer.ID][]byte{"invalid": identityProof}
+	_, err = lc.GetStateProof(height, AccountStateProof, addr)
+	require.Error(t, err)
+	require.Equal(t, []peer.ID{"invalid"}, peers.banned)
+
+	peers.heights = map[peer.ID]uint64{"behind": height - 1, "valid": height}
+	peers.proofs = map[peer.ID][]byte{"behind": identityProof, "valid": accountProof}
+	proof, err := lc.GetStateProof(height, AccountStateProof, addr)
+	require.NoError(t, err)
+	require.Equal(t, accountProof, proof)
+	require.Equal(t, []peer.ID{"invalid"}, peers.banned)
+
+	_, err = lc.GetStateProof(height, IdentityStateProof, addr)
+	require.Error(t, err)
+	require.Equal(t, []peer.ID{"invalid", "valid"}, peers.banned)
+
+	approvedProof, err := chain.GetApprovedIdentityWithProof(height, addr)
+	require.NoError(t, err)
+	require.Nil(t, approvedProof)
+
+	peers.banned = nil
+	peers.proofs = map[peer.ID][]byte{"behind": accountProof, "valid": identityProof}
+	_, err = lc.GetStateProof(height, ApprovedIdentityStateProof, addr)
+	require.Error(t, err)
+	require.Equal(t, []peer.ID{"valid"}, peers.banned)
+
+	chain, _, _, key = blockchain.NewTestBlockchain(true, nil)
+	addr = crypto.PubkeyToAddress(key.PublicKey)
+	height = chain.Head.Height()
+	approvedProof, err = chain.GetApprovedIdentityWithProof(height, addr)
+	require.NoError(t, err)
+	require.NotNil(t, approvedProof)
+
+	lc = NewLightClient(peers, chain.Blockchain)
+	peers.heights = map[peer.ID]uint64{"valid": height}
+	peers.proofs = map[peer.ID][]byte{"valid": approvedProof}
+	proof, err = lc.GetStateProof(height, ApprovedIdentityStateProof, addr)
+	require.NoError(t, err)
+	require.Equal(t, approvedProof, proof)
+}
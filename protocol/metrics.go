@@ -184,6 +184,10 @@ func (h *IdenaGossipHandler) registerMetrics() {
 			return "batchPush"
 		case BatchFlipKey:
 			return "batchFlipKey"
+		case GetStateProof:
+			return "getStateProof"
+		case StateProof:
+			return "stateProof"
 		default:
 			return fmt.Sprintf("unknown code %v", code)
 		}
@@ -200,6 +204,7 @@ func (h *IdenaGossipHandler) registerMetrics() {
 		GetBlockByHash,
 		GetBlocksRange,
 		GetForkBlockRange,
+		GetStateProof,
 		Handshake,
 		NewTx,
 		ProposeBlock,
@@ -207,6 +212,7 @@ func (h *IdenaGossipHandler) registerMetrics() {
 		Pull,
 		Push,
 		SnapshotManifest,
+		StateProof,
 		Vote,
 	}
 
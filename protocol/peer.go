@@ -103,6 +103,10 @@ type protoPeer struct {
 	closed               bool
 	supportedFeatures    map[PeerFeature]struct{}
 	disconnectReason     string
+
+	// stateProofWindowStart and stateProofRequests are used by the peer read loop only
+	stateProofWindowStart time.Time
+	stateProofRequests    int
 }
 
 func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *protoPeer {
@@ -175,6 +179,19 @@ func (p *protoPeer) addFlipKeyToBatch(payload interface{}, shardId common.ShardI
 	}
 }
 
+// allowStateProofRequest limits the number of state proof requests the peer can make per stateProofRequestsWindow
+func (p *protoPeer) allowStateProofRequest(now time.Time) bool {
+	if now.Sub(p.stateProofWindowStart) >= stateProofRequestsWindow {
+		p.stateProofWindowStart = now
+		p.stateProofRequests = 0
+	}
+	if p.stateProofRequests >= maxStateProofRequests {
+		return false
+	}
+	p.stateProofRequests++
+	return true
+}
+
 func (p *protoPeer) sendMsg(msgcode uint64, payload interface{}, shardId common.ShardId, highPriority bool) {
 	if highPriority {
 		timer := time.NewTimer(time.Second * 5)
@@ -385,6 +402,10 @@ func toBytes(msgcode uint64, payload interface{}) ([]byte, error) {
 		return payload.(*msgBatch).ToBytes()
 	case Disconnect:
 		return payload.(*disconnect).ToBytes()
+	case GetStateProof:
+		return proto.Marshal(payload.(*models.ProtoGetStateProofRequest))
+	case StateProof:
+		return proto.Marshal(payload.(*models.ProtoStateProof))
 	}
 	return nil, errors.Errorf("type %T is not serializable", payload)
 }
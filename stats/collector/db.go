@@ -0,0 +1,689 @@
+package collector
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	math2 "github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
+	"github.com/shopspring/decimal"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
+	"sync"
+)
+
+var (
+	blockStatsPrefix = []byte("b")
+	epochStatsPrefix = []byte("e")
+)
+
+const (
+	BalanceUpdateTx                    = "tx"
+	BalanceUpdateVerifiedStakeTransfer = "verifiedStakeTransfer"
+	BalanceUpdateProposerReward        = "proposerReward"
+	BalanceUpdateCommitteeReward       = "committeeReward"
+	BalanceUpdateEpochReward           = "epochReward"
+	BalanceUpdateFailedValidation      = "failedValidation"
+	BalanceUpdatePenalty               = "penalty"
+	BalanceUpdateEpochPenaltyReset     = "epochPenaltyReset"
+	BalanceUpdateDustClearing          = "dustClearing"
+	BalanceUpdateSavedStake            = "savedStake"
+	BalanceUpdateContract              = "contract"
+
+	BurnPenalty             = "penalty"
+	BurnInvite              = "invite"
+	BurnFee                 = "fee"
+	BurnKilled              = "killed"
+	BurnTx                  = "burnTx"
+	BurnContract            = "contract"
+	BurnContractTermination = "contractTermination"
+
+	RewardValidation     = "validation"
+	RewardCandidate      = "candidate"
+	RewardStaking        = "staking"
+	RewardFlips          = "flips"
+	RewardReports        = "reports"
+	RewardInvitations    = "invitations"
+	RewardFoundation     = "foundation"
+	RewardZeroWallet     = "zeroWallet"
+	RewardProposer       = "proposer"
+	RewardFinalCommittee = "finalCommittee"
+)
+
+type BalanceUpdate struct {
+	Address    common.Address `json:"address"`
+	Reason     string         `json:"reason"`
+	TxHash     *common.Hash   `json:"txHash,omitempty"`
+	BalanceOld *big.Int       `json:"balanceOld"`
+	BalanceNew *big.Int       `json:"balanceNew"`
+	StakeOld   *big.Int       `json:"stakeOld"`
+	StakeNew   *big.Int       `json:"stakeNew"`
+	PenaltyOld *big.Int       `json:"penaltyOld"`
+	PenaltyNew *big.Int       `json:"penaltyNew"`
+}
+
+type Burn struct {
+	Address common.Address `json:"address"`
+	Reason  string         `json:"reason"`
+	Amount  *big.Int       `json:"amount"`
+	TxHash  *common.Hash   `json:"txHash,omitempty"`
+}
+
+// Reward is a reward paid to the balance of Address and the stake of StakeAddress which differ for pool members
+type Reward struct {
+	Type         string         `json:"type"`
+	Address      common.Address `json:"address"`
+	StakeAddress common.Address `json:"stakeAddress"`
+	Balance      *big.Int       `json:"balance"`
+	Stake        *big.Int       `json:"stake"`
+}
+
+type BlockStats struct {
+	Height         uint64           `json:"height"`
+	Hash           common.Hash      `json:"hash"`
+	Minted         *big.Int         `json:"minted"`
+	Burnt          *big.Int         `json:"burnt"`
+	Fees           *big.Int         `json:"fees"`
+	Burns          []*Burn          `json:"burns"`
+	BalanceUpdates []*BalanceUpdate `json:"balanceUpdates"`
+	Rewards        []*Reward        `json:"rewards"`
+}
+
+type RewardTotal struct {
+	Amount *big.Int `json:"amount"`
+	// Share is the reward of a single share, e.g. a flip or a unit of age weighted validation score
+	Share *big.Int `json:"share,omitempty"`
+}
+
+// EpochStats is the reward distribution of the validation finishing the epoch
+type EpochStats struct {
+	Epoch   uint16                  `json:"epoch"`
+	Height  uint64                  `json:"height"`
+	Total   *big.Int                `json:"total"`
+	Totals  map[string]*RewardTotal `json:"totals"`
+	Rewards []*Reward               `json:"rewards"`
+}
+
+// StatsStore provides stats persisted by a collector
+type StatsStore interface {
+	BlockStats(height uint64) (*BlockStats, error)
+	EpochStats(epoch uint16) (*EpochStats, error)
+}
+
+type balanceSnapshot struct {
+	address common.Address
+	reason  string
+	balance *big.Int
+	stake   *big.Int
+	penalty *big.Int
+}
+
+// DbCollector persists balance updates, minted and burnt coins of added blocks and reward distributions of epochs to the database.
+// Data is collected between EnableCollecting and CompleteCollecting calls and saved if the block is added to the chain.
+type DbCollector struct {
+	collectorStub
+	db  dbm.DB
+	log log.Logger
+
+	lock       sync.Mutex
+	closed     bool
+	collecting bool
+	block      *BlockStats
+	added      bool
+	epoch      *EpochStats
+	pending    []*balanceSnapshot
+	tx         *types.Transaction
+	txBurns    []*Burn
+	// txBalanceUpdates are balance changes made by the contract of the applying tx, one per address
+	txBalanceUpdates []*BalanceUpdate
+}
+
+func NewDbCollector(db dbm.DB, bus eventbus.Bus) *DbCollector {
+	c := &DbCollector{db: db, log: log.New("component", "stats")}
+	bus.Subscribe(events.AddBlockEventID, func(e eventbus.Event) {
+		c.onNewBlock(e.(*events.NewBlockEvent).Block)
+	})
+	bus.Subscribe(events.BlockchainResetEventID, func(e eventbus.Event) {
+		c.onReset(e.(*events.BlockchainResetEvent).Header.Height())
+	})
+	return c
+}
+
+// Close closes the database, stats of blocks added after that are not saved
+func (c *DbCollector) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.db.Close()
+}
+
+func blockStatsKey(height uint64) []byte {
+	key := make([]byte, len(blockStatsPrefix)+8)
+	copy(key, blockStatsPrefix)
+	binary.BigEndian.PutUint64(key[len(blockStatsPrefix):], height)
+	return key
+}
+
+func epochStatsKey(epoch uint16) []byte {
+	key := make([]byte, len(epochStatsPrefix)+2)
+	copy(key, epochStatsPrefix)
+	binary.BigEndian.PutUint16(key[len(epochStatsPrefix):], epoch)
+	return key
+}
+
+func (c *DbCollector) BlockStats(height uint64) (*BlockStats, error) {
+	data, err := c.db.Get(blockStatsKey(height))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	res := new(BlockStats)
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *DbCollector) EpochStats(epoch uint16) (*EpochStats, error) {
+	data, err := c.db.Get(epochStatsKey(epoch))
+	if err != nil || data == nil {
+		return nil, err
+	}
+	res := new(EpochStats)
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *DbCollector) EnableCollecting() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.collecting = true
+	c.added = false
+	c.block = &BlockStats{Minted: new(big.Int), Burnt: new(big.Int), Fees: new(big.Int)}
+	c.epoch = nil
+	c.pending = nil
+	c.tx = nil
+	c.txBurns = nil
+	c.txBalanceUpdates = nil
+}
+
+func (c *DbCollector) CompleteCollecting() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.collecting && c.added && !c.closed {
+		if err := c.save(); err != nil {
+			c.log.Error("Failed to save stats", "height", c.block.Height, "err", err)
+		}
+	}
+	c.collecting = false
+	c.block = nil
+	c.epoch = nil
+	c.pending = nil
+}
+
+func (c *DbCollector) save() error {
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	data, err := json.Marshal(c.block)
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(blockStatsKey(c.block.Height), data); err != nil {
+		return err
+	}
+	if c.epoch != nil {
+		c.epoch.Height = c.block.Height
+		data, err := json.Marshal(c.epoch)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(epochStatsKey(c.epoch.Epoch), data); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+func (c *DbCollector) onNewBlock(block *types.Block) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	c.block.Height = block.Height()
+	c.block.Hash = block.Hash()
+	c.added = true
+}
+
+// onReset removes stats of reverted blocks
+func (c *DbCollector) onReset(height uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.closed {
+		return
+	}
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	it, err := c.db.Iterator(blockStatsKey(height+1), blockStatsKey(^uint64(0)))
+	if err != nil {
+		c.log.Error("Failed to reset stats", "err", err)
+		return
+	}
+	for ; it.Valid(); it.Next() {
+		batch.Delete(it.Key())
+	}
+	it.Close()
+	it, err = c.db.Iterator(epochStatsKey(0), epochStatsKey(^uint16(0)))
+	if err != nil {
+		c.log.Error("Failed to reset stats", "err", err)
+		return
+	}
+	for ; it.Valid(); it.Next() {
+		stats := new(EpochStats)
+		if err := json.Unmarshal(it.Value(), stats); err == nil && stats.Height > height {
+			batch.Delete(it.Key())
+		}
+	}
+	it.Close()
+	if err := batch.WriteSync(); err != nil {
+		c.log.Error("Failed to reset stats", "err", err)
+	}
+}
+
+func (c *DbCollector) txHash() *common.Hash {
+	if c.tx == nil {
+		return nil
+	}
+	hash := c.tx.Hash()
+	return &hash
+}
+
+func (c *DbCollector) addBurn(addr common.Address, reason string, amount *big.Int, txHash *common.Hash) {
+	if !c.collecting || amount == nil || amount.Sign() <= 0 {
+		return
+	}
+	c.block.Burnt.Add(c.block.Burnt, amount)
+	c.block.Burns = append(c.block.Burns, &Burn{Address: addr, Reason: reason, Amount: new(big.Int).Set(amount), TxHash: txHash})
+}
+
+func copyInt(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(v)
+}
+
+func (c *DbCollector) epochStats() *EpochStats {
+	if c.epoch == nil {
+		c.epoch = &EpochStats{Total: new(big.Int), Totals: map[string]*RewardTotal{}}
+	}
+	return c.epoch
+}
+
+func (c *DbCollector) setTotal(rewardType string, amount, share *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	total := &RewardTotal{Amount: copyInt(amount)}
+	if share != nil {
+		total.Share = copyInt(share)
+	}
+	c.epochStats().Totals[rewardType] = total
+}
+
+func (c *DbCollector) addEpochReward(rewardType string, balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	epoch := c.epochStats()
+	epoch.Rewards = append(epoch.Rewards, &Reward{Type: rewardType, Address: balanceDest, StakeAddress: stakeDest,
+		Balance: copyInt(balance), Stake: copyInt(stake)})
+}
+
+func (c *DbCollector) addBlockReward(rewardType string, balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	c.block.Rewards = append(c.block.Rewards, &Reward{Type: rewardType, Address: balanceDest, StakeAddress: stakeDest,
+		Balance: copyInt(balance), Stake: copyInt(stake)})
+}
+
+func (c *DbCollector) SetTotalReward(amount *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	c.epochStats().Total = copyInt(amount)
+}
+
+func (c *DbCollector) SetTotalValidationReward(amount *big.Int, share *big.Int) {
+	c.setTotal(RewardValidation, amount, share)
+}
+
+func (c *DbCollector) SetTotalStakingReward(amount *big.Int, share *big.Int) {
+	c.setTotal(RewardStaking, amount, share)
+}
+
+func (c *DbCollector) SetTotalCandidateReward(amount *big.Int, share *big.Int) {
+	c.setTotal(RewardCandidate, amount, share)
+}
+
+func (c *DbCollector) SetTotalFlipsReward(amount *big.Int, share *big.Int) {
+	c.setTotal(RewardFlips, amount, share)
+}
+
+func (c *DbCollector) SetTotalReportsReward(amount *big.Int, share *big.Int) {
+	c.setTotal(RewardReports, amount, share)
+}
+
+func (c *DbCollector) SetTotalInvitationsReward(amount *big.Int, share *big.Int) {
+	c.setTotal(RewardInvitations, amount, share)
+}
+
+func (c *DbCollector) SetTotalFoundationPayouts(amount *big.Int) {
+	c.setTotal(RewardFoundation, amount, nil)
+}
+
+func (c *DbCollector) SetTotalZeroWalletFund(amount *big.Int) {
+	c.setTotal(RewardZeroWallet, amount, nil)
+}
+
+func (c *DbCollector) AddValidationReward(balanceDest, stakeDest common.Address, age uint16, balance, stake *big.Int) {
+	c.addEpochReward(RewardValidation, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddCandidateReward(balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	c.addEpochReward(RewardCandidate, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddStakingReward(balanceDest, stakeDest common.Address, stakedAmount *big.Int, balance, stake *big.Int) {
+	c.addEpochReward(RewardStaking, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddFlipsReward(balanceDest, stakeDest common.Address, balance, stake *big.Int, flipsToReward []*types.FlipToReward) {
+	c.addEpochReward(RewardFlips, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddReportedFlipsReward(balanceDest, stakeDest common.Address, shardId common.ShardId, flipIdx int, balance, stake *big.Int) {
+	c.addEpochReward(RewardReports, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddInvitationsReward(balanceDest, stakeDest common.Address, balance, stake *big.Int, age uint16, txHash *common.Hash,
+	epochHeight uint32, isSavedInviteWinner bool) {
+	c.addEpochReward(RewardInvitations, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddFoundationPayout(addr common.Address, balance *big.Int) {
+	c.addEpochReward(RewardFoundation, addr, addr, balance, nil)
+}
+
+func (c *DbCollector) AddZeroWalletFund(addr common.Address, balance *big.Int) {
+	c.addEpochReward(RewardZeroWallet, addr, addr, balance, nil)
+}
+
+func (c *DbCollector) AddProposerReward(balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	c.addBlockReward(RewardProposer, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddFinalCommitteeReward(balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	c.addBlockReward(RewardFinalCommittee, balanceDest, stakeDest, balance, stake)
+}
+
+func (c *DbCollector) AddMintedCoins(amount *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting || amount == nil {
+		return
+	}
+	c.block.Minted.Add(c.block.Minted, amount)
+}
+
+func (c *DbCollector) AddTxFee(feeAmount *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting || feeAmount == nil {
+		return
+	}
+	c.block.Fees.Add(c.block.Fees, feeAmount)
+}
+
+func (c *DbCollector) AddPenaltyBurntCoins(addr common.Address, amount *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.addBurn(addr, BurnPenalty, amount, nil)
+}
+
+func (c *DbCollector) AddInviteBurntCoins(addr common.Address, amount *big.Int, tx *types.Transaction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hash := tx.Hash()
+	c.addBurn(addr, BurnInvite, amount, &hash)
+}
+
+func (c *DbCollector) AddFeeBurntCoins(addr common.Address, feeAmount *big.Int, burntRate float32, tx *types.Transaction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if feeAmount == nil {
+		return
+	}
+	burnt := math2.ToInt(decimal.NewFromBigInt(feeAmount, 0).Mul(decimal.NewFromFloat32(burntRate)))
+	hash := tx.Hash()
+	c.addBurn(addr, BurnFee, burnt, &hash)
+}
+
+func (c *DbCollector) AddKilledBurntCoins(addr common.Address, amount *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.addBurn(addr, BurnKilled, amount, nil)
+}
+
+func (c *DbCollector) AddBurnTxBurntCoins(addr common.Address, tx *types.Transaction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hash := tx.Hash()
+	c.addBurn(addr, BurnTx, tx.AmountOrZero(), &hash)
+}
+
+// AddContractBurntCoins and AddContractTerminationBurntCoins are called during contract execution,
+// the burns are saved if the contract transaction succeeds
+func (c *DbCollector) AddContractBurntCoins(address common.Address, getAmount GetBalanceFunc) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	c.txBurns = append(c.txBurns, &Burn{Address: address, Reason: BurnContract, Amount: copyInt(getAmount(address)), TxHash: c.txHash()})
+}
+
+func (c *DbCollector) AddContractTerminationBurntCoins(address common.Address, stake, refund *big.Int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting || stake == nil {
+		return
+	}
+	amount := new(big.Int).Sub(stake, copyInt(refund))
+	c.txBurns = append(c.txBurns, &Burn{Address: address, Reason: BurnContractTermination, Amount: amount, TxHash: c.txHash()})
+}
+
+func (c *DbCollector) AddTxReceipt(txReceipt *types.TxReceipt, appState *appstate.AppState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if txReceipt.Success && c.collecting {
+		for _, burn := range c.txBurns {
+			c.addBurn(burn.Address, burn.Reason, burn.Amount, burn.TxHash)
+		}
+		for _, update := range c.txBalanceUpdates {
+			if update.BalanceOld.Cmp(update.BalanceNew) != 0 {
+				c.block.BalanceUpdates = append(c.block.BalanceUpdates, update)
+			}
+		}
+	}
+	c.txBurns = nil
+	c.txBalanceUpdates = nil
+}
+
+// AddContractBalanceUpdate is called during contract execution for every balance change,
+// changes of an address are merged and saved if the contract transaction succeeds
+func (c *DbCollector) AddContractBalanceUpdate(address common.Address, getCurrentBalance GetBalanceFunc, newBalance *big.Int, appState *appstate.AppState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	for _, update := range c.txBalanceUpdates {
+		if update.Address == address {
+			update.BalanceNew = copyInt(newBalance)
+			return
+		}
+	}
+	stake := copyInt(appState.State.GetStakeBalance(address))
+	penalty := copyInt(appState.State.GetPenalty(address))
+	c.txBalanceUpdates = append(c.txBalanceUpdates, &BalanceUpdate{
+		Address:    address,
+		Reason:     BalanceUpdateContract,
+		TxHash:     c.txHash(),
+		BalanceOld: copyInt(getCurrentBalance(address)),
+		BalanceNew: copyInt(newBalance),
+		StakeOld:   stake,
+		StakeNew:   new(big.Int).Set(stake),
+		PenaltyOld: penalty,
+		PenaltyNew: new(big.Int).Set(penalty),
+	})
+}
+
+func (c *DbCollector) BeginApplyingTx(tx *types.Transaction, appState *appstate.AppState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.tx = tx
+	c.txBurns = nil
+	c.txBalanceUpdates = nil
+}
+
+func (c *DbCollector) CompleteApplyingTx(appState *appstate.AppState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.tx = nil
+	c.txBurns = nil
+	c.txBalanceUpdates = nil
+}
+
+func (c *DbCollector) beginBalanceUpdate(reason string, appState *appstate.AppState, addresses ...common.Address) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	for _, addr := range addresses {
+		c.pending = append(c.pending, &balanceSnapshot{
+			address: addr,
+			reason:  reason,
+			balance: copyInt(appState.State.GetBalance(addr)),
+			stake:   copyInt(appState.State.GetStakeBalance(addr)),
+			penalty: copyInt(appState.State.GetPenalty(addr)),
+		})
+	}
+}
+
+func (c *DbCollector) BeginVerifiedStakeTransferBalanceUpdate(addrFrom, addrTo common.Address, appState *appstate.AppState) {
+	c.beginBalanceUpdate(BalanceUpdateVerifiedStakeTransfer, appState, addrFrom, addrTo)
+}
+
+func (c *DbCollector) BeginTxBalanceUpdate(tx *types.Transaction, appState *appstate.AppState) {
+	sender, _ := types.Sender(tx)
+	if tx.To != nil && *tx.To != sender {
+		c.beginBalanceUpdate(BalanceUpdateTx, appState, sender, *tx.To)
+		return
+	}
+	c.beginBalanceUpdate(BalanceUpdateTx, appState, sender)
+}
+
+func (c *DbCollector) BeginProposerRewardBalanceUpdate(balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	c.beginRewardBalanceUpdate(BalanceUpdateProposerReward, balanceDest, stakeDest, appState)
+}
+
+func (c *DbCollector) BeginCommitteeRewardBalanceUpdate(balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	c.beginRewardBalanceUpdate(BalanceUpdateCommitteeReward, balanceDest, stakeDest, appState)
+}
+
+func (c *DbCollector) BeginEpochRewardBalanceUpdate(balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	c.lock.Lock()
+	if c.collecting {
+		// rewards are paid before the epoch increment
+		c.epochStats().Epoch = appState.State.Epoch()
+	}
+	c.lock.Unlock()
+	c.beginRewardBalanceUpdate(BalanceUpdateEpochReward, balanceDest, stakeDest, appState)
+}
+
+func (c *DbCollector) beginRewardBalanceUpdate(reason string, balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	if balanceDest != stakeDest {
+		c.beginBalanceUpdate(reason, appState, balanceDest, stakeDest)
+		return
+	}
+	c.beginBalanceUpdate(reason, appState, balanceDest)
+}
+
+func (c *DbCollector) BeginFailedValidationBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	c.beginBalanceUpdate(BalanceUpdateFailedValidation, appState, addr)
+}
+
+func (c *DbCollector) BeginPenaltyBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	c.beginBalanceUpdate(BalanceUpdatePenalty, appState, addr)
+}
+
+func (c *DbCollector) BeginEpochPenaltyResetBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	c.beginBalanceUpdate(BalanceUpdateEpochPenaltyReset, appState, addr)
+}
+
+func (c *DbCollector) BeginDustClearingBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	c.beginBalanceUpdate(BalanceUpdateDustClearing, appState, addr)
+}
+
+func (c *DbCollector) BeginSavedStakeBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	c.beginBalanceUpdate(BalanceUpdateSavedStake, appState, addr)
+}
+
+func (c *DbCollector) CompleteBalanceUpdate(appState *appstate.AppState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.collecting {
+		return
+	}
+	txHash := c.txHash()
+	for _, snapshot := range c.pending {
+		update := &BalanceUpdate{
+			Address:    snapshot.address,
+			Reason:     snapshot.reason,
+			BalanceOld: snapshot.balance,
+			BalanceNew: copyInt(appState.State.GetBalance(snapshot.address)),
+			StakeOld:   snapshot.stake,
+			StakeNew:   copyInt(appState.State.GetStakeBalance(snapshot.address)),
+			PenaltyOld: snapshot.penalty,
+			PenaltyNew: copyInt(appState.State.GetPenalty(snapshot.address)),
+		}
+		if update.BalanceOld.Cmp(update.BalanceNew) == 0 && update.StakeOld.Cmp(update.StakeNew) == 0 &&
+			update.PenaltyOld.Cmp(update.PenaltyNew) == 0 {
+			continue
+		}
+		if snapshot.reason == BalanceUpdateTx {
+			update.TxHash = txHash
+		}
+		c.block.BalanceUpdates = append(c.block.BalanceUpdates, update)
+	}
+	c.pending = nil
+}
@@ -0,0 +1,125 @@
+package collector
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/events"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func testBlock(height uint64) *types.Block {
+	return &types.Block{
+		Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: height}},
+		Body:   &types.Body{},
+	}
+}
+
+func TestDbCollector(t *testing.T) {
+	bus := eventbus.New()
+	c := NewDbCollector(dbm.NewMemDB(), bus)
+	appState, _ := appstate.NewAppState(dbm.NewMemDB(), bus)
+	appState.State.SetGlobalEpoch(3)
+
+	addr := common.Address{0x1}
+	stakeAddr := common.Address{0x2}
+	contract := common.Address{0x3}
+
+	c.EnableCollecting()
+	c.AddMintedCoins(big.NewInt(100))
+	c.AddKilledBurntCoins(addr, big.NewInt(7))
+	c.AddPenaltyBurntCoins(addr, big.NewInt(0))
+
+	c.BeginEpochRewardBalanceUpdate(addr, stakeAddr, appState)
+	appState.State.AddBalance(addr, big.NewInt(20))
+	appState.State.AddStake(stakeAddr, big.NewInt(80))
+	c.CompleteBalanceUpdate(appState)
+	c.SetTotalReward(big.NewInt(1000))
+	c.SetTotalValidationReward(big.NewInt(500), big.NewInt(5))
+	c.AddValidationReward(addr, stakeAddr, 2, big.NewInt(20), big.NewInt(80))
+
+	tx := &types.Transaction{AccountNonce: 1, Type: types.CallContractTx}
+	balance := func(value int64) GetBalanceFunc {
+		return func(common.Address) *big.Int {
+			return big.NewInt(value)
+		}
+	}
+	c.BeginApplyingTx(tx, appState)
+	c.AddContractTerminationBurntCoins(contract, big.NewInt(10), big.NewInt(4))
+	c.AddContractBalanceUpdate(contract, balance(50), big.NewInt(40), appState)
+	c.AddTxReceipt(&types.TxReceipt{Success: false}, appState)
+	c.CompleteApplyingTx(appState)
+
+	c.BeginApplyingTx(tx, appState)
+	c.AddContractTerminationBurntCoins(contract, big.NewInt(10), big.NewInt(4))
+	c.AddContractBalanceUpdate(contract, balance(50), big.NewInt(40), appState)
+	c.AddContractBalanceUpdate(stakeAddr, balance(0), big.NewInt(10), appState)
+	c.AddContractBalanceUpdate(contract, balance(40), big.NewInt(30), appState)
+	c.AddContractBalanceUpdate(stakeAddr, balance(10), big.NewInt(0), appState)
+	c.AddTxReceipt(&types.TxReceipt{Success: true}, appState)
+	c.CompleteApplyingTx(appState)
+
+	block := testBlock(5)
+	bus.Publish(&events.NewBlockEvent{Block: block})
+	c.CompleteCollecting()
+
+	stats, err := c.BlockStats(5)
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), stats.Hash)
+	require.Equal(t, big.NewInt(100), stats.Minted)
+	require.Equal(t, big.NewInt(13), stats.Burnt)
+	require.Len(t, stats.Burns, 2)
+	require.Equal(t, BurnContractTermination, stats.Burns[1].Reason)
+	require.Equal(t, tx.Hash(), *stats.Burns[1].TxHash)
+	require.Len(t, stats.BalanceUpdates, 3)
+	require.Equal(t, BalanceUpdateEpochReward, stats.BalanceUpdates[0].Reason)
+	require.Equal(t, big.NewInt(20), stats.BalanceUpdates[0].BalanceNew)
+	require.Equal(t, big.NewInt(80), stats.BalanceUpdates[1].StakeNew)
+	// contract balance updates of an address are merged, updates without changes are skipped
+	require.Equal(t, BalanceUpdateContract, stats.BalanceUpdates[2].Reason)
+	require.Equal(t, contract, stats.BalanceUpdates[2].Address)
+	require.Equal(t, big.NewInt(50), stats.BalanceUpdates[2].BalanceOld)
+	require.Equal(t, big.NewInt(30), stats.BalanceUpdates[2].BalanceNew)
+	require.Equal(t, tx.Hash(), *stats.BalanceUpdates[2].TxHash)
+
+	epoch, err := c.EpochStats(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), epoch.Height)
+	require.Equal(t, big.NewInt(1000), epoch.Total)
+	require.Equal(t, big.NewInt(5), epoch.Totals[RewardValidation].Share)
+	require.Len(t, epoch.Rewards, 1)
+	require.Equal(t, stakeAddr, epoch.Rewards[0].StakeAddress)
+
+	// stats of rejected blocks are not saved
+	c.EnableCollecting()
+	c.AddMintedCoins(big.NewInt(100))
+	c.CompleteCollecting()
+	stats, err = c.BlockStats(6)
+	require.NoError(t, err)
+	require.Nil(t, stats)
+
+	c.EnableCollecting()
+	bus.Publish(&events.NewBlockEvent{Block: testBlock(6)})
+	c.CompleteCollecting()
+	stats, _ = c.BlockStats(6)
+	require.NotNil(t, stats)
+
+	bus.Publish(&events.BlockchainResetEvent{Header: testBlock(4).Header})
+	stats, _ = c.BlockStats(5)
+	require.Nil(t, stats)
+	stats, _ = c.BlockStats(6)
+	require.Nil(t, stats)
+	epoch, _ = c.EpochStats(3)
+	require.Nil(t, epoch)
+
+	// stats are not saved after the database is closed
+	require.NoError(t, c.Close())
+	require.NoError(t, c.Close())
+	c.EnableCollecting()
+	bus.Publish(&events.NewBlockEvent{Block: testBlock(7)})
+	require.NotPanics(t, c.CompleteCollecting)
+}
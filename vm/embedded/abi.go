@@ -0,0 +1,434 @@
+package embedded
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/common/math"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"math/big"
+	"strconv"
+)
+
+// Arg formats match formats of contract rpc dynamic args and contract data conversion
+const (
+	AddressFormat = "address"
+	ByteFormat    = "byte"
+	Uint16Format  = "uint16"
+	Uint64Format  = "uint64"
+	BigIntFormat  = "bigint"
+	DnaFormat     = "dna"
+	HexFormat     = "hex"
+	StringFormat  = "string"
+)
+
+// ConvertArg converts the value in the arg format to contract argument bytes, values of unknown formats are hex
+func ConvertArg(format, value string) ([]byte, error) {
+	switch format {
+	case ByteFormat:
+		i, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return nil, errors.Errorf("cannot parse byte: \"%v\"", value)
+		}
+		return []byte{byte(i)}, nil
+	case Uint16Format:
+		i, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return nil, errors.Errorf("cannot parse uint16: \"%v\"", value)
+		}
+		return common.ToBytes(uint16(i)), nil
+	case Uint64Format:
+		i, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("cannot parse uint64: \"%v\"", value)
+		}
+		return common.ToBytes(i), nil
+	case StringFormat:
+		return []byte(value), nil
+	case AddressFormat:
+		if !common.IsHexAddress(value) {
+			return nil, errors.Errorf("cannot parse address: \"%v\"", value)
+		}
+		return common.HexToAddress(value).Bytes(), nil
+	case BigIntFormat:
+		v, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, errors.Errorf("cannot parse bigint: \"%v\"", value)
+		}
+		return v.Bytes(), nil
+	case DnaFormat:
+		d, err := decimal.NewFromString(value)
+		if err != nil {
+			return nil, errors.Errorf("cannot parse dna: \"%v\"", value)
+		}
+		return math.ToInt(d.Mul(decimal.NewFromBigInt(common.DnaBase, 0))).Bytes(), nil
+	default:
+		data, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, errors.Errorf("cannot parse hex: \"%v\"", value)
+		}
+		return data, nil
+	}
+}
+
+type ArgDescriptor struct {
+	Name     string `json:"name"`
+	Format   string `json:"format"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+type MethodDescriptor struct {
+	Name string          `json:"name"`
+	Args []ArgDescriptor `json:"args"`
+	// Result is the format of the read method result
+	Result string `json:"result,omitempty"`
+}
+
+type StorageDescriptor struct {
+	Key    string `json:"key"`
+	Format string `json:"format"`
+	// MapKeyFormat is set for maps, values are stored by the key prefixed with the map name
+	MapKeyFormat string `json:"mapKeyFormat,omitempty"`
+}
+
+type EventDescriptor struct {
+	Name string          `json:"name"`
+	Args []ArgDescriptor `json:"args"`
+}
+
+// ContractDescriptor describes arguments expected by an embedded contract, its storage layout and emitted events
+type ContractDescriptor struct {
+	Name      string              `json:"name"`
+	Deploy    []ArgDescriptor     `json:"deploy"`
+	Call      []MethodDescriptor  `json:"call"`
+	Read      []MethodDescriptor  `json:"read"`
+	Terminate []ArgDescriptor     `json:"terminate"`
+	Storage   []StorageDescriptor `json:"storage"`
+	Events    []EventDescriptor   `json:"events"`
+}
+
+func (d *ContractDescriptor) CallMethod(name string) (MethodDescriptor, bool) {
+	return findMethod(d.Call, name)
+}
+
+func (d *ContractDescriptor) ReadMethod(name string) (MethodDescriptor, bool) {
+	return findMethod(d.Read, name)
+}
+
+func findMethod(methods []MethodDescriptor, name string) (MethodDescriptor, bool) {
+	for _, m := range methods {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return MethodDescriptor{}, false
+}
+
+func arg(name, format string) ArgDescriptor {
+	return ArgDescriptor{Name: name, Format: format}
+}
+
+func optionalArg(name, format string) ArgDescriptor {
+	return ArgDescriptor{Name: name, Format: format, Optional: true}
+}
+
+var ownerStorage = StorageDescriptor{Key: "owner", Format: AddressFormat}
+
+var Descriptors map[EmbeddedContractType]*ContractDescriptor
+
+// initDescriptors is called after code hashes of contracts are set
+func initDescriptors() {
+	Descriptors = make(map[EmbeddedContractType]*ContractDescriptor)
+	Descriptors[TimeLockContract] = &ContractDescriptor{
+		Name:   "TimeLock",
+		Deploy: []ArgDescriptor{arg("timestamp", Uint64Format)},
+		Call: []MethodDescriptor{
+			{Name: "transfer", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+		},
+		Read: []MethodDescriptor{
+			{Name: "timestamp", Result: Uint64Format},
+			{Name: "owner", Result: AddressFormat},
+		},
+		Terminate: []ArgDescriptor{arg("dest", AddressFormat)},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "timestamp", Format: Uint64Format},
+		},
+	}
+
+	Descriptors[OracleVotingContract] = &ContractDescriptor{
+		Name: "OracleVoting",
+		Deploy: []ArgDescriptor{
+			arg("fact", HexFormat),
+			arg("startTime", Uint64Format),
+			optionalArg("votingDuration", Uint64Format),
+			optionalArg("publicVotingDuration", Uint64Format),
+			optionalArg("winnerThreshold", ByteFormat),
+			optionalArg("quorum", ByteFormat),
+			optionalArg("committeeSize", Uint64Format),
+			optionalArg("votingMinPayment", DnaFormat),
+			optionalArg("ownerFee", ByteFormat),
+		},
+		Call: []MethodDescriptor{
+			{Name: "startVoting"},
+			{Name: "sendVoteProof", Args: []ArgDescriptor{arg("voteHash", HexFormat)}},
+			{Name: "sendVote", Args: []ArgDescriptor{arg("vote", ByteFormat), arg("salt", HexFormat)}},
+			{Name: FinishVotingMethod},
+			{Name: "prolongVoting"},
+			{Name: "addStake"},
+		},
+		Read: []MethodDescriptor{
+			{Name: "proof", Args: []ArgDescriptor{arg("addr", AddressFormat)}, Result: ByteFormat},
+			{Name: "voteHash", Args: []ArgDescriptor{arg("vote", ByteFormat), arg("salt", HexFormat)}, Result: HexFormat},
+			{Name: "voteBlock", Result: Uint64Format},
+		},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: keyFact, Format: HexFormat},
+			{Key: "startTime", Format: Uint64Format},
+			{Key: "state", Format: ByteFormat},
+			{Key: "votingDuration", Format: Uint64Format},
+			{Key: "publicVotingDuration", Format: Uint64Format},
+			{Key: "winnerThreshold", Format: ByteFormat},
+			{Key: "quorum", Format: ByteFormat},
+			{Key: "committeeSize", Format: Uint64Format},
+			{Key: "ownerFee", Format: ByteFormat},
+			{Key: "votingMinPayment", Format: DnaFormat},
+			{Key: "startBlock", Format: Uint64Format},
+			{Key: "network", Format: Uint64Format},
+			{Key: "vrfSeed", Format: HexFormat},
+			{Key: "epoch", Format: Uint16Format},
+			{Key: "votedCount", Format: Uint64Format},
+			{Key: "secretVotesCount", Format: Uint64Format},
+			{Key: "prolongVoteCount", Format: Uint64Format},
+			{Key: keyResult, Format: ByteFormat},
+			{Key: "voteHashes", Format: HexFormat, MapKeyFormat: AddressFormat},
+			{Key: "votes", Format: ByteFormat, MapKeyFormat: AddressFormat},
+			{Key: "voteOptions", Format: Uint64Format, MapKeyFormat: ByteFormat},
+			{Key: "poolVotes", Format: ByteFormat, MapKeyFormat: AddressFormat},
+			{Key: "allVotes", Format: Uint64Format, MapKeyFormat: ByteFormat},
+		},
+		Events: []EventDescriptor{
+			{Name: "reward", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+		},
+	}
+
+	Descriptors[OracleLockContract] = &ContractDescriptor{
+		Name: "OracleLock",
+		Deploy: []ArgDescriptor{
+			arg("oracleVotingAddr", AddressFormat),
+			arg("value", ByteFormat),
+			arg("successAddr", AddressFormat),
+			arg("failAddr", AddressFormat),
+		},
+		Call: []MethodDescriptor{
+			{Name: "push"},
+			{Name: "checkOracleVoting"},
+		},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "oracleVotingAddr", Format: AddressFormat},
+			{Key: "value", Format: ByteFormat},
+			{Key: "successAddr", Format: AddressFormat},
+			{Key: "failAddr", Format: AddressFormat},
+			{Key: "isOracleVotingFinished", Format: ByteFormat},
+			{Key: "hasVotedValue", Format: ByteFormat},
+			{Key: "voted", Format: ByteFormat},
+		},
+	}
+
+	Descriptors[RefundableOracleLockContract] = &ContractDescriptor{
+		Name: "RefundableOracleLock",
+		Deploy: []ArgDescriptor{
+			arg("oracleVoting", AddressFormat),
+			arg("value", ByteFormat),
+			optionalArg("successAddr", AddressFormat),
+			optionalArg("failAddr", AddressFormat),
+			optionalArg("refundDelay", Uint64Format),
+			arg("depositDeadline", Uint64Format),
+			arg("factEvidenceFee", ByteFormat),
+		},
+		Call: []MethodDescriptor{
+			{Name: "deposit"},
+			{Name: "push"},
+			{Name: "refund"},
+		},
+		Terminate: []ArgDescriptor{arg("dest", AddressFormat)},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "oracleVoting", Format: AddressFormat},
+			{Key: "value", Format: ByteFormat},
+			{Key: "successAddr", Format: AddressFormat},
+			{Key: "failAddr", Format: AddressFormat},
+			{Key: "refundDelay", Format: Uint64Format},
+			{Key: "depositDeadline", Format: Uint64Format},
+			{Key: "factEvidenceFee", Format: ByteFormat},
+			{Key: "state", Format: ByteFormat},
+			{Key: "sum", Format: DnaFormat},
+			{Key: "refundBlock", Format: Uint64Format},
+			{Key: "deposits", Format: DnaFormat, MapKeyFormat: AddressFormat},
+		},
+		Events: []EventDescriptor{
+			{Name: "refund", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+		},
+	}
+
+	Descriptors[MultisigContract] = &ContractDescriptor{
+		Name:   "Multisig",
+		Deploy: []ArgDescriptor{arg("maxVotes", ByteFormat), arg("minVotes", ByteFormat)},
+		Call: []MethodDescriptor{
+			{Name: "add", Args: []ArgDescriptor{arg("addr", AddressFormat)}},
+			{Name: "send", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+			{Name: "push", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+		},
+		Read: []MethodDescriptor{
+			{Name: "state", Result: ByteFormat},
+			{Name: "minVotes", Result: ByteFormat},
+			{Name: "maxVotes", Result: ByteFormat},
+			{Name: "owners", Result: HexFormat},
+			{Name: "voteAddress", Args: []ArgDescriptor{arg("owner", AddressFormat)}, Result: AddressFormat},
+			{Name: "voteAmount", Args: []ArgDescriptor{arg("owner", AddressFormat)}, Result: DnaFormat},
+			{Name: "votes", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}, Result: Uint64Format},
+		},
+		Terminate: []ArgDescriptor{arg("dest", AddressFormat)},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "maxVotes", Format: ByteFormat},
+			{Key: "minVotes", Format: ByteFormat},
+			{Key: "state", Format: ByteFormat},
+			{Key: "count", Format: ByteFormat},
+			{Key: "addr", Format: AddressFormat, MapKeyFormat: AddressFormat},
+			{Key: "amount", Format: DnaFormat, MapKeyFormat: AddressFormat},
+		},
+	}
+
+	Descriptors[FungibleTokenContract] = &ContractDescriptor{
+		Name: "FungibleToken",
+		Deploy: []ArgDescriptor{
+			arg("name", StringFormat),
+			arg("symbol", StringFormat),
+			arg("decimals", ByteFormat),
+			arg("supply", BigIntFormat),
+		},
+		Call: []MethodDescriptor{
+			{Name: "transfer", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", BigIntFormat)}},
+			{Name: "approve", Args: []ArgDescriptor{arg("spender", AddressFormat), arg("amount", BigIntFormat)}},
+			{Name: "transferFrom", Args: []ArgDescriptor{arg("from", AddressFormat), arg("dest", AddressFormat), arg("amount", BigIntFormat)}},
+			{Name: "mint", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", BigIntFormat)}},
+			{Name: "burn", Args: []ArgDescriptor{arg("amount", BigIntFormat)}},
+		},
+		Read: []MethodDescriptor{
+			{Name: "name", Result: StringFormat},
+			{Name: "symbol", Result: StringFormat},
+			{Name: "decimals", Result: ByteFormat},
+			{Name: "totalSupply", Result: BigIntFormat},
+			{Name: "balanceOf", Args: []ArgDescriptor{arg("addr", AddressFormat)}, Result: BigIntFormat},
+			{Name: "allowance", Args: []ArgDescriptor{arg("owner", AddressFormat), arg("spender", AddressFormat)}, Result: BigIntFormat},
+		},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "name", Format: StringFormat},
+			{Key: "symbol", Format: StringFormat},
+			{Key: "decimals", Format: ByteFormat},
+			{Key: "totalSupply", Format: BigIntFormat},
+			{Key: "b", Format: BigIntFormat, MapKeyFormat: AddressFormat},
+			// allowances are stored by the truncated keccak256 hash of owner and spender addresses
+			{Key: "a", Format: BigIntFormat, MapKeyFormat: HexFormat},
+		},
+		Events: []EventDescriptor{
+			{Name: "transfer", Args: []ArgDescriptor{arg("from", AddressFormat), arg("to", AddressFormat), arg("amount", BigIntFormat)}},
+			{Name: "approval", Args: []ArgDescriptor{arg("owner", AddressFormat), arg("spender", AddressFormat), arg("amount", BigIntFormat)}},
+		},
+	}
+
+	Descriptors[VestingContract] = &ContractDescriptor{
+		Name: "Vesting",
+		Deploy: []ArgDescriptor{
+			arg("beneficiary", AddressFormat),
+			arg("start", Uint64Format),
+			arg("cliff", Uint64Format),
+			arg("duration", Uint64Format),
+			optionalArg("revocable", ByteFormat),
+		},
+		Call: []MethodDescriptor{
+			{Name: "withdraw"},
+			{Name: "revoke"},
+		},
+		Read: []MethodDescriptor{
+			{Name: "beneficiary", Result: AddressFormat},
+			{Name: "owner", Result: AddressFormat},
+			{Name: "start", Result: Uint64Format},
+			{Name: "cliff", Result: Uint64Format},
+			{Name: "duration", Result: Uint64Format},
+			{Name: "revocable", Result: ByteFormat},
+			{Name: "revoked", Result: ByteFormat},
+			{Name: "vested", Result: DnaFormat},
+			{Name: "withdrawn", Result: DnaFormat},
+			{Name: "releasable", Result: DnaFormat},
+		},
+		Terminate: []ArgDescriptor{arg("dest", AddressFormat)},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "beneficiary", Format: AddressFormat},
+			{Key: "start", Format: Uint64Format},
+			{Key: "cliff", Format: Uint64Format},
+			{Key: "duration", Format: Uint64Format},
+			{Key: "revocable", Format: ByteFormat},
+			{Key: "revoked", Format: ByteFormat},
+			{Key: "revokedVested", Format: DnaFormat},
+			{Key: "withdrawn", Format: DnaFormat},
+		},
+		Events: []EventDescriptor{
+			{Name: "withdraw", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+			{Name: "revoke", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+		},
+	}
+
+	// signers are passed as args starting from index 2
+	multisig2Deploy := []ArgDescriptor{arg("minVotes", ByteFormat), optionalArg("lifetime", Uint64Format)}
+	for i := 0; i < maxMultisig2Signers; i++ {
+		multisig2Deploy = append(multisig2Deploy, optionalArg(fmt.Sprintf("signer%v", i), AddressFormat))
+	}
+	proposalId := arg("id", Uint64Format)
+	Descriptors[Multisig2Contract] = &ContractDescriptor{
+		Name:   "Multisig2",
+		Deploy: multisig2Deploy,
+		Call: []MethodDescriptor{
+			{Name: "proposeSend", Args: []ArgDescriptor{arg("dest", AddressFormat), arg("amount", DnaFormat)}},
+			{Name: "proposeThreshold", Args: []ArgDescriptor{arg("minVotes", ByteFormat)}},
+			{Name: "proposeAddSigner", Args: []ArgDescriptor{arg("addr", AddressFormat)}},
+			{Name: "proposeRemoveSigner", Args: []ArgDescriptor{arg("addr", AddressFormat)}},
+			{Name: "approve", Args: []ArgDescriptor{proposalId}},
+			{Name: "revoke", Args: []ArgDescriptor{proposalId}},
+			{Name: "execute", Args: []ArgDescriptor{proposalId}},
+		},
+		Read: []MethodDescriptor{
+			{Name: "minVotes", Result: ByteFormat},
+			{Name: "lifetime", Result: Uint64Format},
+			{Name: "signers", Result: HexFormat},
+			{Name: "proposals", Result: HexFormat},
+			{Name: "proposal", Args: []ArgDescriptor{proposalId}, Result: HexFormat},
+			{Name: "approvals", Args: []ArgDescriptor{proposalId}, Result: HexFormat},
+		},
+		Terminate: []ArgDescriptor{arg("dest", AddressFormat)},
+		Storage: []StorageDescriptor{
+			ownerStorage,
+			{Key: "minVotes", Format: ByteFormat},
+			{Key: "count", Format: ByteFormat},
+			{Key: "lifetime", Format: Uint64Format},
+			{Key: "nextId", Format: Uint64Format},
+			{Key: "s", Format: ByteFormat, MapKeyFormat: AddressFormat},
+			// proposal is stored as kind (1 byte), expiry height (8 bytes), target address (20 bytes) and value bytes
+			{Key: "p", Format: HexFormat, MapKeyFormat: Uint64Format},
+			// approvals are stored by the proposal id followed by the signer address
+			{Key: "v", Format: ByteFormat, MapKeyFormat: HexFormat},
+		},
+		Events: []EventDescriptor{
+			{Name: "proposal", Args: []ArgDescriptor{proposalId, arg("kind", ByteFormat), arg("signer", AddressFormat)}},
+			{Name: "approve", Args: []ArgDescriptor{proposalId, arg("signer", AddressFormat)}},
+			{Name: "revoke", Args: []ArgDescriptor{proposalId, arg("signer", AddressFormat)}},
+			{Name: "execute", Args: []ArgDescriptor{proposalId}},
+		},
+	}
+}
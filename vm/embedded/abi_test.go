@@ -0,0 +1,36 @@
+package embedded
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDescriptors(t *testing.T) {
+	formats := map[string]bool{AddressFormat: true, ByteFormat: true, Uint16Format: true, Uint64Format: true,
+		BigIntFormat: true, DnaFormat: true, HexFormat: true, StringFormat: true}
+	checkArgs := func(args []ArgDescriptor) {
+		for _, a := range args {
+			require.NotEmpty(t, a.Name)
+			require.True(t, formats[a.Format], a.Format)
+		}
+	}
+	for codeHash := range AvailableContracts {
+		descriptor, ok := Descriptors[codeHash]
+		require.True(t, ok, "descriptor of %v is missing", codeHash.Hex())
+		checkArgs(descriptor.Deploy)
+		checkArgs(descriptor.Terminate)
+		for _, m := range append(descriptor.Call, descriptor.Read...) {
+			checkArgs(m.Args)
+		}
+		for _, m := range descriptor.Read {
+			require.True(t, formats[m.Result], m.Result)
+		}
+		for _, s := range descriptor.Storage {
+			require.True(t, formats[s.Format], s.Format)
+		}
+	}
+	_, ok := Descriptors[TimeLockContract].CallMethod("transfer")
+	require.True(t, ok)
+	_, ok = Descriptors[TimeLockContract].CallMethod("unknown")
+	require.False(t, ok)
+}
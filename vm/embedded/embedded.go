@@ -16,6 +16,9 @@ var (
 	OracleLockContract           EmbeddedContractType
 	RefundableOracleLockContract EmbeddedContractType
 	MultisigContract             EmbeddedContractType
+	FungibleTokenContract        EmbeddedContractType
+	VestingContract              EmbeddedContractType
+	Multisig2Contract            EmbeddedContractType
 	AvailableContracts           map[EmbeddedContractType]struct{}
 )
 
@@ -25,6 +28,9 @@ func init() {
 	OracleLockContract.SetBytes([]byte{0x3})
 	RefundableOracleLockContract.SetBytes([]byte{0x4})
 	MultisigContract.SetBytes([]byte{0x5})
+	FungibleTokenContract.SetBytes([]byte{0x6})
+	VestingContract.SetBytes([]byte{0x7})
+	Multisig2Contract.SetBytes([]byte{0x8})
 
 	AvailableContracts = map[EmbeddedContractType]struct{}{
 		TimeLockContract:             {},
@@ -32,7 +38,11 @@ func init() {
 		OracleLockContract:           {},
 		RefundableOracleLockContract: {},
 		MultisigContract:             {},
+		FungibleTokenContract:        {},
+		VestingContract:              {},
+		Multisig2Contract:            {},
 	}
+	initDescriptors()
 }
 
 type Contract interface {
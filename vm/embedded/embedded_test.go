@@ -2,19 +2,14 @@ package embedded
 
 import (
 	"crypto/ecdsa"
-	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
-	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
-	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/vm/env"
-	"github.com/pkg/errors"
-	dbm "github.com/tendermint/tm-db"
+	"github.com/idena-network/idena-go/vm/vmtest"
 	"math/big"
-	"math/rand"
 )
 
 func createHeader(height uint64, time int64) *types.Header {
@@ -29,19 +24,19 @@ func createHeader(height uint64, time int64) *types.Header {
 	}
 }
 
+// contractTester runs a single contract of the owner with vmtest.Tester, the first identity of the network is the owner
 type contractTester struct {
-	db       dbm.DB
+	tester *vmtest.Tester
+
 	mainKey  *ecdsa.PrivateKey
 	mainAddr common.Address
 
 	appState *appstate.AppState
-	secStore *secstore.SecStore
 
 	initialOwnerContractBalance *big.Int
 	contractAddr                common.Address
 	identities                  []*ecdsa.PrivateKey
 	env                         *env.EnvImp
-	contractInstance            Contract
 	height                      uint64
 	timestamp                   int64
 }
@@ -83,77 +78,37 @@ func (b *contractTesterBuilder) SetInitialOwnerContractBalance(balance *big.Int)
 	return b
 }
 
-func (b *contractTesterBuilder) getIdentityConfig(i int) (identityGroupConfig, bool) {
-	if len(b.network.identityGroups) <= i {
-		return identityGroupConfig{}, false
-	}
-	return b.network.identityGroups[i], true
-}
-
 func (b *contractTesterBuilder) Build() *contractTester {
-
-	db := dbm.NewMemDB()
-	appState, _ := appstate.NewAppState(db, eventbus.New())
-
-	appState.State.SetFeePerGas(big.NewInt(1))
-	rnd := rand.New(rand.NewSource(1))
-	key, _ := crypto.GenerateKeyFromSeed(rnd)
+	builder := vmtest.NewBuilder(createContract)
+	for _, cfg := range b.network.identityGroups {
+		builder.AddIdentities(vmtest.IdentityGroup{
+			Count:               1,
+			State:               cfg.state,
+			Delegatee:           cfg.delegatee,
+			PendingUndelegation: cfg.pendingUndelegation,
+		})
+	}
+	tester, err := builder.Build()
+	if err != nil {
+		panic(err)
+	}
+	appState := tester.AppState()
+	key, _ := tester.Key(0)
 	addr := crypto.PubkeyToAddress(key.PublicKey)
 	appState.State.SetBalance(addr, b.initialOwnerContractBalance)
-	appState.State.SetPubKey(addr, crypto.FromECDSAPub(&key.PublicKey))
-	if cfg, ok := b.getIdentityConfig(0); ok {
-		appState.State.SetState(addr, cfg.state)
-		if cfg.state.NewbieOrBetter() {
-			appState.IdentityState.SetValidated(addr, true)
-		}
-		if cfg.pendingUndelegation {
-			appState.State.SetPendingUndelegation(addr)
-		}
-		if cfg.delegatee != nil {
-			appState.State.SetDelegatee(addr, *cfg.delegatee)
-			if !cfg.pendingUndelegation {
-				appState.IdentityState.SetDelegatee(addr, *cfg.delegatee)
-			}
-		}
-	} else {
-		appState.State.SetState(addr, state.Newbie)
-		appState.IdentityState.SetValidated(addr, true)
-	}
-
-	secStore := secstore.NewSecStore()
+	appState.Commit(nil, true)
 
 	var identities []*ecdsa.PrivateKey
-
-	for i := 1; i < len(b.network.identityGroups); i++ {
-		key, _ := crypto.GenerateKeyFromSeed(rnd)
+	for i := 1; i < tester.IdentitiesCount(); i++ {
+		key, _ := tester.Key(i)
 		identities = append(identities, key)
-		addr := crypto.PubkeyToAddress(key.PublicKey)
-		appState.State.SetPubKey(addr, crypto.FromECDSAPub(&key.PublicKey))
-		cfg := b.network.identityGroups[i]
-		appState.State.SetState(addr, cfg.state)
-		if cfg.state.NewbieOrBetter() {
-			appState.IdentityState.SetValidated(addr, true)
-		}
-		if cfg.pendingUndelegation {
-			appState.State.SetPendingUndelegation(addr)
-		}
-		if cfg.delegatee != nil {
-			appState.State.SetDelegatee(addr, *cfg.delegatee)
-			if !cfg.pendingUndelegation {
-				appState.IdentityState.SetDelegatee(addr, *cfg.delegatee)
-			}
-		}
 	}
-	appState.Commit(nil, true)
-
-	appState.Initialize(1)
 	return &contractTester{
-		db:                          db,
+		tester:                      tester,
 		appState:                    appState,
 		mainKey:                     key,
 		mainAddr:                    addr,
 		initialOwnerContractBalance: b.initialOwnerContractBalance,
-		secStore:                    secStore,
 		identities:                  identities,
 	}
 }
@@ -175,6 +130,22 @@ func (s *deployContractSwitch) OracleVoting() *configurableOracleVotingDeploy {
 	}
 }
 
+func (s *deployContractSwitch) Multisig() *configurableMultisigDeploy {
+	return &configurableMultisigDeploy{
+		contractTester: s.contractTester,
+		deployStake:    s.deployStake,
+		maxVotes:       3,
+		minVotes:       2,
+	}
+}
+
+func (s *deployContractSwitch) TimeLock() *configurableTimeLockDeploy {
+	return &configurableTimeLockDeploy{
+		contractTester: s.contractTester,
+		deployStake:    s.deployStake,
+	}
+}
+
 type configurableDeploy interface {
 	Parameters() (contract EmbeddedContractType, deployStake *big.Int, params [][]byte)
 }
@@ -183,7 +154,7 @@ func (c *contractTester) ConfigureDeploy(deployStake *big.Int) *deployContractSw
 	return &deployContractSwitch{contractTester: c, deployStake: deployStake}
 }
 
-func (c *contractTester) createContract(ctx env.CallContext, e env.Env) Contract {
+func createContract(ctx env.CallContext, e env.Env) vmtest.Contract {
 	switch ctx.CodeHash() {
 	case TimeLockContract:
 		return NewTimeLock(ctx, e, nil)
@@ -195,88 +166,43 @@ func (c *contractTester) createContract(ctx env.CallContext, e env.Env) Contract
 		return NewRefundableOracleLock2(ctx, e, nil)
 	case MultisigContract:
 		return NewMultisig(ctx, e, nil)
+	case FungibleTokenContract:
+		return NewFungibleToken(ctx, e, nil)
+	case VestingContract:
+		return NewVesting(ctx, e, nil)
+	case Multisig2Contract:
+		return NewMultisig2(ctx, e, nil)
 	default:
 		return nil
 	}
 }
 
-func (c *contractTester) Deploy(config configurableDeploy) error {
+// sync passes the height and the timestamp set by the test to the tester
+func (c *contractTester) sync() {
+	c.tester.SetHeight(c.height)
+	c.tester.SetTimestamp(c.timestamp)
+}
 
+func (c *contractTester) Deploy(config configurableDeploy) error {
 	contractType, deployStake, deployParams := config.Parameters()
-
-	attachment := attachments.CreateDeployContractAttachment(contractType, deployParams...)
-	payload, err := attachment.ToBytes()
-	if err != nil {
-		return err
-	}
-
-	tx := &types.Transaction{
-		Epoch:        0,
-		AccountNonce: 1,
-		Type:         types.DeployContractTx,
-		Amount:       deployStake,
-		Payload:      payload,
-	}
-	tx, _ = types.SignTx(tx, c.mainKey)
-	ctx := env.NewDeployContextImpl(tx, nil, attachment.CodeHash)
-
-	gas := new(env.GasCounter)
-	gas.Reset(-1)
-
-	// deploy
-	c.env = env.NewEnvImp(c.appState, createHeader(2, 1), gas, nil)
-	c.contractAddr = ctx.ContractAddr()
-	c.contractInstance = c.createContract(ctx, c.env)
-	err = c.contractInstance.Deploy(attachment.Args...)
-	c.env.Deploy(ctx)
+	c.sync()
+	addr, err := c.tester.Deploy(c.mainKey, contractType, deployStake, deployParams...)
+	c.contractAddr = addr
+	c.env = c.tester.Env()
 	return err
 }
 
 func (c *contractTester) Call(key *ecdsa.PrivateKey, contract EmbeddedContractType, payment *big.Int, method string, args ...[]byte) error {
-	callAttach := attachments.CreateCallContractAttachment(method, args...)
-	payload, _ := callAttach.ToBytes()
-	tx := &types.Transaction{
-		Epoch:        0,
-		AccountNonce: 2,
-		To:           &c.contractAddr,
-		Type:         types.CallContractTx,
-		Payload:      payload,
-		Amount:       payment,
-	}
-	tx, _ = types.SignTx(tx, key)
-	gas := new(env.GasCounter)
-	gas.Reset(-1)
-
-	ctx := env.NewCallContextImpl(tx, nil, contract)
-
-	c.env = env.NewEnvImp(c.appState, createHeader(c.height, c.timestamp), gas, nil)
-	c.contractInstance = c.createContract(ctx, c.env)
-	return c.contractInstance.Call(callAttach.Method, callAttach.Args...)
+	c.sync()
+	err := c.tester.Call(key, c.contractAddr, payment, method, args...)
+	c.env = c.tester.Env()
+	return err
 }
 
 func (c *contractTester) Terminate(key *ecdsa.PrivateKey, contract EmbeddedContractType) (common.Address, error) {
-	terminateAttach := attachments.CreateTerminateContractAttachment()
-	payload, _ := terminateAttach.ToBytes()
-	tx := &types.Transaction{
-		Epoch:        0,
-		AccountNonce: 2,
-		To:           &c.contractAddr,
-		Type:         types.TerminateContractTx,
-		Payload:      payload,
-	}
-	tx, _ = types.SignTx(tx, key)
-
-	gas := new(env.GasCounter)
-	gas.Reset(-1)
-
-	ctx := env.NewCallContextImpl(tx, nil, contract)
-
-	c.env = env.NewEnvImp(c.appState, createHeader(c.height, c.timestamp), gas, nil)
-	c.contractInstance = c.createContract(ctx, c.env)
-	dest, keysToSave, err := c.contractInstance.Terminate(terminateAttach.Args...)
-	if err == nil {
-		c.env.Terminate(ctx, keysToSave, dest)
-	}
+	c.sync()
+	dest, err := c.tester.Terminate(key, c.contractAddr)
+	c.env = c.tester.Env()
 	return dest, err
 }
 
@@ -289,15 +215,11 @@ func (c *contractTester) IdentityCall(identityIndex int, contract EmbeddedContra
 }
 
 func (c *contractTester) Read(contract EmbeddedContractType, method string, bytes ...[]byte) ([]byte, error) {
-	if c.contractInstance == nil {
-		return nil, errors.New("no contract instance")
-	}
-	return c.contractInstance.Read(method, bytes...)
+	return c.tester.Read(c.contractAddr, method, bytes...)
 }
 
-func (c *contractTester) Commit() {
-	c.env.Commit()
-	c.appState.Commit(nil, true)
+func (c *contractTester) Commit() []*types.TxEvent {
+	return c.tester.Commit()
 }
 
 func (c *contractTester) SetBalance(balance *big.Int) {
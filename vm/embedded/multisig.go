@@ -71,7 +71,52 @@ func (m *Multisig) Call(method string, args ...[]byte) (err error) {
 }
 
 func (m *Multisig) Read(method string, args ...[]byte) ([]byte, error) {
-	panic("implement me")
+	switch method {
+	case "state":
+		return []byte{m.GetByte("state")}, nil
+	case "minVotes":
+		return []byte{m.GetByte("minVotes")}, nil
+	case "maxVotes":
+		return []byte{m.GetByte("maxVotes")}, nil
+	case "owners":
+		var owners []byte
+		m.voteAddress.Iterate(func(key []byte, value []byte) bool {
+			owners = append(owners, key...)
+			return false
+		})
+		return owners, nil
+	case "voteAddress":
+		addr, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		dest := m.voteAddress.Get(addr.Bytes())
+		if dest == nil {
+			return nil, errors.New("unknown owner")
+		}
+		return dest, nil
+	case "voteAmount":
+		addr, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		if m.voteAddress.Get(addr.Bytes()) == nil {
+			return nil, errors.New("unknown owner")
+		}
+		return m.voteAmount.Get(addr.Bytes()), nil
+	case "votes":
+		dest, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := helpers.ExtractArray(1, args...)
+		if err != nil {
+			return nil, err
+		}
+		return common.ToBytes(uint64(m.countVotes(dest, amount))), nil
+	default:
+		return nil, errors.New("unknown method")
+	}
 }
 
 func (m *Multisig) add(args ...[]byte) (err error) {
@@ -141,15 +186,7 @@ func (m *Multisig) push(args ...[]byte) error {
 	if err != nil {
 		return err
 	}
-	votes := 0
-	m.voteAddress.Iterate(func(key []byte, value []byte) bool {
-		if bytes.Compare(value, dest.Bytes()) == 0 {
-			if bytes.Compare(m.voteAmount.Get(key), amount) == 0 {
-				votes++
-			}
-		}
-		return false
-	})
+	votes := m.countVotes(dest, amount)
 
 	minVotes := m.GetByte("minVotes")
 	if votes < int(minVotes) {
@@ -168,6 +205,19 @@ func (m *Multisig) push(args ...[]byte) error {
 	return nil
 }
 
+func (m *Multisig) countVotes(dest common.Address, amount []byte) int {
+	votes := 0
+	m.voteAddress.Iterate(func(key []byte, value []byte) bool {
+		if bytes.Compare(value, dest.Bytes()) == 0 {
+			if bytes.Compare(m.voteAmount.Get(key), amount) == 0 {
+				votes++
+			}
+		}
+		return false
+	})
+	return votes
+}
+
 func (m *Multisig) Terminate(args ...[]byte) (common.Address, [][]byte, error) {
 	if !m.IsOwner() {
 		return common.Address{}, nil, errors.New("sender is not an owner")
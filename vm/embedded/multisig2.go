@@ -0,0 +1,404 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/idena-network/idena-go/vm/helpers"
+	"github.com/pkg/errors"
+	"math"
+	"math/big"
+)
+
+const (
+	maxMultisig2Signers              = 32
+	defaultMultisig2ProposalLifetime = uint64(4320)
+)
+
+type multisig2ProposalKind = byte
+
+const (
+	multisig2SendProposal         multisig2ProposalKind = 1
+	multisig2ThresholdProposal    multisig2ProposalKind = 2
+	multisig2AddSignerProposal    multisig2ProposalKind = 3
+	multisig2RemoveSignerProposal multisig2ProposalKind = 4
+)
+
+// multisig2Proposal is stored as kind (1 byte), expiry height (8 bytes), target address (20 bytes) and value bytes
+type multisig2Proposal struct {
+	kind   multisig2ProposalKind
+	expiry uint64
+	target common.Address
+	value  []byte
+}
+
+func (p *multisig2Proposal) toBytes() []byte {
+	data := append([]byte{p.kind}, common.ToBytes(p.expiry)...)
+	data = append(data, p.target.Bytes()...)
+	return append(data, p.value...)
+}
+
+func (p *multisig2Proposal) fromBytes(data []byte) error {
+	if len(data) < 1+8+common.AddressLength {
+		return errors.New("invalid proposal data")
+	}
+	p.kind = data[0]
+	p.expiry, _ = helpers.ExtractUInt64(0, data[1:9])
+	p.target.SetBytes(data[9 : 9+common.AddressLength])
+	p.value = data[9+common.AddressLength:]
+	return nil
+}
+
+// Multisig2 executes proposals to send coins or change the signers set after approvals of minVotes signers.
+// Every proposal has its own approvals and expires after the lifetime in blocks, so proposals don't block each other.
+type Multisig2 struct {
+	*BaseContract
+	signers   *env.Map
+	proposals *env.Map
+}
+
+func NewMultisig2(ctx env.CallContext, e env.Env, statsCollector collector.StatsCollector) *Multisig2 {
+	return &Multisig2{&BaseContract{
+		ctx:            ctx,
+		env:            e,
+		statsCollector: statsCollector,
+	}, env.NewMap([]byte("s"), e, ctx), env.NewMap([]byte("p"), e, ctx)}
+}
+
+func (m *Multisig2) approvals(id uint64) *env.Map {
+	return env.NewMap(append([]byte("v"), common.ToBytes(id)...), m.env, m.ctx)
+}
+
+func (m *Multisig2) Deploy(args ...[]byte) error {
+	minVotes, err := helpers.ExtractByte(0, args...)
+	if err != nil {
+		return err
+	}
+	lifetime := defaultMultisig2ProposalLifetime
+	if value, err := helpers.ExtractUInt64(1, args...); err == nil && value > 0 {
+		lifetime = value
+	}
+	if lifetime > math.MaxUint64-m.env.BlockNumber() {
+		return errors.New("lifetime is too big")
+	}
+	var count byte
+	for i := 2; i < len(args); i++ {
+		signer, err := helpers.ExtractAddr(i, args...)
+		if err != nil {
+			return err
+		}
+		if m.isSigner(signer) {
+			return errors.New("duplicated signer")
+		}
+		if count == maxMultisig2Signers {
+			return errors.Errorf("signers count should be in range [1;%v]", maxMultisig2Signers)
+		}
+		m.signers.Set(signer.Bytes(), []byte{1})
+		count++
+	}
+	if count == 0 {
+		return errors.Errorf("signers count should be in range [1;%v]", maxMultisig2Signers)
+	}
+	if minVotes < 1 || minVotes > count {
+		return errors.New("minvotes should be in range [1;signers count]")
+	}
+	m.SetByte("minVotes", minVotes)
+	m.SetByte("count", count)
+	m.SetUint64("lifetime", lifetime)
+	m.SetUint64("nextId", 1)
+	m.SetOwner(m.ctx.Sender())
+	return nil
+}
+
+func (m *Multisig2) Call(method string, args ...[]byte) error {
+	switch method {
+	case "proposeSend":
+		dest, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return err
+		}
+		amount, err := helpers.ExtractBigInt(1, args...)
+		if err != nil {
+			return err
+		}
+		if amount.Sign() <= 0 {
+			return errors.New("amount should be positive")
+		}
+		return m.propose(multisig2SendProposal, dest, amount.Bytes())
+	case "proposeThreshold":
+		minVotes, err := helpers.ExtractByte(0, args...)
+		if err != nil {
+			return err
+		}
+		return m.propose(multisig2ThresholdProposal, common.Address{}, []byte{minVotes})
+	case "proposeAddSigner":
+		addr, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return err
+		}
+		return m.propose(multisig2AddSignerProposal, addr, nil)
+	case "proposeRemoveSigner":
+		addr, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return err
+		}
+		return m.propose(multisig2RemoveSignerProposal, addr, nil)
+	case "approve":
+		return m.approve(args...)
+	case "revoke":
+		return m.revoke(args...)
+	case "execute":
+		return m.execute(args...)
+	default:
+		return errors.New("unknown method")
+	}
+}
+
+func (m *Multisig2) Read(method string, args ...[]byte) ([]byte, error) {
+	switch method {
+	case "minVotes":
+		return []byte{m.GetByte("minVotes")}, nil
+	case "lifetime":
+		return common.ToBytes(m.GetUint64("lifetime")), nil
+	case "signers":
+		var signers []byte
+		m.signers.Iterate(func(key []byte, value []byte) bool {
+			signers = append(signers, key...)
+			return false
+		})
+		return signers, nil
+	case "proposals":
+		var ids []byte
+		m.proposals.Iterate(func(key []byte, value []byte) bool {
+			var p multisig2Proposal
+			if p.fromBytes(value) == nil && p.expiry >= m.env.BlockNumber() {
+				ids = append(ids, key...)
+			}
+			return false
+		})
+		return ids, nil
+	case "proposal":
+		id, err := helpers.ExtractUInt64(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		data := m.proposals.Get(common.ToBytes(id))
+		if data == nil {
+			return nil, errors.New("unknown proposal")
+		}
+		return data, nil
+	case "approvals":
+		id, err := helpers.ExtractUInt64(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		var approvals []byte
+		m.approvals(id).Iterate(func(key []byte, value []byte) bool {
+			approvals = append(approvals, key...)
+			return false
+		})
+		return approvals, nil
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
+
+func (m *Multisig2) isSigner(addr common.Address) bool {
+	return m.signers.Get(addr.Bytes()) != nil
+}
+
+func (m *Multisig2) checkSender() error {
+	if !m.isSigner(m.ctx.Sender()) {
+		return errors.New("sender is not a signer")
+	}
+	return nil
+}
+
+// openProposal returns the proposal with the id from args if it exists and is not expired
+func (m *Multisig2) openProposal(args ...[]byte) (uint64, *multisig2Proposal, error) {
+	id, err := helpers.ExtractUInt64(0, args...)
+	if err != nil {
+		return 0, nil, err
+	}
+	data := m.proposals.Get(common.ToBytes(id))
+	if data == nil {
+		return 0, nil, errors.New("unknown proposal")
+	}
+	p := new(multisig2Proposal)
+	if err := p.fromBytes(data); err != nil {
+		return 0, nil, err
+	}
+	if p.expiry < m.env.BlockNumber() {
+		return 0, nil, errors.New("proposal is expired")
+	}
+	return id, p, nil
+}
+
+func (m *Multisig2) propose(kind multisig2ProposalKind, target common.Address, value []byte) error {
+	if err := m.checkSender(); err != nil {
+		return err
+	}
+	lifetime := m.GetUint64("lifetime")
+	if lifetime > math.MaxUint64-m.env.BlockNumber() {
+		return errors.New("proposal expiry overflows")
+	}
+	m.removeExpiredProposals()
+	id := m.GetUint64("nextId")
+	p := &multisig2Proposal{
+		kind:   kind,
+		expiry: m.env.BlockNumber() + lifetime,
+		target: target,
+		value:  value,
+	}
+	m.proposals.Set(common.ToBytes(id), p.toBytes())
+	m.SetUint64("nextId", id+1)
+	m.approvals(id).Set(m.ctx.Sender().Bytes(), []byte{1})
+	m.env.Event(m.ctx, "proposal", common.ToBytes(id), []byte{kind}, m.ctx.Sender().Bytes())
+	return nil
+}
+
+func (m *Multisig2) approve(args ...[]byte) error {
+	if err := m.checkSender(); err != nil {
+		return err
+	}
+	id, _, err := m.openProposal(args...)
+	if err != nil {
+		return err
+	}
+	approvals := m.approvals(id)
+	if approvals.Get(m.ctx.Sender().Bytes()) != nil {
+		return errors.New("proposal is approved by sender")
+	}
+	approvals.Set(m.ctx.Sender().Bytes(), []byte{1})
+	m.env.Event(m.ctx, "approve", common.ToBytes(id), m.ctx.Sender().Bytes())
+	return nil
+}
+
+func (m *Multisig2) revoke(args ...[]byte) error {
+	if err := m.checkSender(); err != nil {
+		return err
+	}
+	id, _, err := m.openProposal(args...)
+	if err != nil {
+		return err
+	}
+	approvals := m.approvals(id)
+	if approvals.Get(m.ctx.Sender().Bytes()) == nil {
+		return errors.New("proposal is not approved by sender")
+	}
+	approvals.Remove(m.ctx.Sender().Bytes())
+	m.env.Event(m.ctx, "revoke", common.ToBytes(id), m.ctx.Sender().Bytes())
+	return nil
+}
+
+// countApprovals counts approvals of current signers only, so approvals of removed signers are ignored
+func (m *Multisig2) countApprovals(id uint64) byte {
+	var votes byte
+	m.approvals(id).Iterate(func(key []byte, value []byte) bool {
+		var addr common.Address
+		addr.SetBytes(key)
+		if m.isSigner(addr) {
+			votes++
+		}
+		return false
+	})
+	return votes
+}
+
+func (m *Multisig2) execute(args ...[]byte) error {
+	if err := m.checkSender(); err != nil {
+		return err
+	}
+	id, p, err := m.openProposal(args...)
+	if err != nil {
+		return err
+	}
+	minVotes := m.GetByte("minVotes")
+	if m.countApprovals(id) < minVotes {
+		return errors.New("votes < minVotes")
+	}
+	count := m.GetByte("count")
+	switch p.kind {
+	case multisig2SendProposal:
+		if err := m.env.Send(m.ctx, p.target, new(big.Int).SetBytes(p.value)); err != nil {
+			return err
+		}
+	case multisig2ThresholdProposal:
+		if len(p.value) != 1 || p.value[0] < 1 || p.value[0] > count {
+			return errors.New("minvotes should be in range [1;signers count]")
+		}
+		m.SetByte("minVotes", p.value[0])
+	case multisig2AddSignerProposal:
+		if m.isSigner(p.target) {
+			return errors.New("address is a signer")
+		}
+		if count == maxMultisig2Signers {
+			return errors.New("too many signers")
+		}
+		m.signers.Set(p.target.Bytes(), []byte{1})
+		m.SetByte("count", count+1)
+	case multisig2RemoveSignerProposal:
+		if !m.isSigner(p.target) {
+			return errors.New("address is not a signer")
+		}
+		if count-1 < minVotes {
+			return errors.New("signers count cannot be less than minVotes")
+		}
+		m.signers.Remove(p.target.Bytes())
+		m.SetByte("count", count-1)
+	default:
+		return errors.New("unknown proposal kind")
+	}
+	m.removeProposal(id)
+	m.env.Event(m.ctx, "execute", common.ToBytes(id))
+	return nil
+}
+
+func (m *Multisig2) removeProposal(id uint64) {
+	m.proposals.Remove(common.ToBytes(id))
+	approvals := m.approvals(id)
+	var voters [][]byte
+	approvals.Iterate(func(key []byte, value []byte) bool {
+		voters = append(voters, key)
+		return false
+	})
+	for _, voter := range voters {
+		approvals.Remove(voter)
+	}
+}
+
+// removeExpiredProposals deletes expired proposals with their approvals, it is called on every new proposal
+// so the number of stored proposals is limited by proposals created during the lifetime
+func (m *Multisig2) removeExpiredProposals() {
+	var expired []uint64
+	m.proposals.Iterate(func(key []byte, value []byte) bool {
+		var p multisig2Proposal
+		if p.fromBytes(value) == nil && p.expiry < m.env.BlockNumber() {
+			id, _ := helpers.ExtractUInt64(0, key)
+			expired = append(expired, id)
+		}
+		return false
+	})
+	for _, id := range expired {
+		m.removeProposal(id)
+	}
+}
+
+func (m *Multisig2) Terminate(args ...[]byte) (common.Address, [][]byte, error) {
+	if !m.IsOwner() {
+		return common.Address{}, nil, errors.New("sender is not an owner")
+	}
+	balance := m.env.Balance(m.ctx.ContractAddr())
+	dust := big.NewInt(0).Mul(m.env.MinFeePerGas(), big.NewInt(100))
+	if balance.Cmp(dust) > 0 {
+		return common.Address{}, nil, errors.New("contract has dna")
+	}
+	if balance.Sign() > 0 {
+		m.env.BurnAll(m.ctx)
+	}
+	dest, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return dest, nil, nil
+}
@@ -0,0 +1,146 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/big"
+	"testing"
+)
+
+type configurableMultisig2Deploy struct {
+	contractTester *contractTester
+	deployStake    *big.Int
+
+	minVotes byte
+	lifetime uint64
+	signers  []common.Address
+}
+
+func (c *configurableMultisig2Deploy) Parameters() (contract EmbeddedContractType, deployStake *big.Int, params [][]byte) {
+	params = [][]byte{{c.minVotes}, common.ToBytes(c.lifetime)}
+	for _, signer := range c.signers {
+		params = append(params, signer.Bytes())
+	}
+	return Multisig2Contract, c.deployStake, params
+}
+
+func (c *configurableMultisig2Deploy) SetSigners(signers []common.Address) *configurableMultisig2Deploy {
+	c.signers = signers
+	return c
+}
+
+func (c *configurableMultisig2Deploy) SetLifetime(lifetime uint64) *configurableMultisig2Deploy {
+	c.lifetime = lifetime
+	return c
+}
+
+func (c *configurableMultisig2Deploy) Deploy() error {
+	return c.contractTester.Deploy(c)
+}
+
+func (s *deployContractSwitch) Multisig2() *configurableMultisig2Deploy {
+	return &configurableMultisig2Deploy{
+		contractTester: s.contractTester,
+		deployStake:    s.deployStake,
+		minVotes:       2,
+		lifetime:       10,
+	}
+}
+
+func TestMultisig2(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 5, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	var signers []common.Address
+	for _, key := range tester.identities[:3] {
+		signers = append(signers, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	newSigner := crypto.PubkeyToAddress(tester.identities[3].PublicKey)
+	dest := common.Address{0x1}
+
+	require.Error(t, tester.ConfigureDeploy(common.DnaBase).Multisig2().SetSigners(append(signers, signers[0])).Deploy())
+	require.NoError(t, tester.ConfigureDeploy(common.DnaBase).Multisig2().SetSigners(signers).Deploy())
+	tester.Commit()
+	tester.AddBalance(big.NewInt(1000))
+	tester.height = 3
+
+	require.Error(t, tester.OwnerCall(Multisig2Contract, "proposeSend", dest.Bytes(), big.NewInt(100).Bytes()))
+	require.NoError(t, tester.IdentityCall(0, Multisig2Contract, "proposeSend", dest.Bytes(), big.NewInt(100).Bytes()))
+	tester.Commit()
+	require.NoError(t, tester.IdentityCall(1, Multisig2Contract, "proposeAddSigner", newSigner.Bytes()))
+	tester.Commit()
+
+	data, err := tester.Read(Multisig2Contract, "proposals")
+	require.NoError(t, err)
+	require.Len(t, data, 16)
+
+	// proposals are approved independently
+	require.Error(t, tester.IdentityCall(0, Multisig2Contract, "execute", common.ToBytes(uint64(1))))
+	require.NoError(t, tester.IdentityCall(2, Multisig2Contract, "approve", common.ToBytes(uint64(2))))
+	tester.Commit()
+	require.NoError(t, tester.IdentityCall(2, Multisig2Contract, "execute", common.ToBytes(uint64(2))))
+	tester.Commit()
+	data, _ = tester.Read(Multisig2Contract, "signers")
+	require.Len(t, data, 4*common.AddressLength)
+
+	require.NoError(t, tester.IdentityCall(3, Multisig2Contract, "approve", common.ToBytes(uint64(1))))
+	tester.Commit()
+	require.NoError(t, tester.IdentityCall(3, Multisig2Contract, "revoke", common.ToBytes(uint64(1))))
+	tester.Commit()
+	require.Error(t, tester.IdentityCall(3, Multisig2Contract, "revoke", common.ToBytes(uint64(1))))
+	require.Error(t, tester.IdentityCall(0, Multisig2Contract, "execute", common.ToBytes(uint64(1))))
+	require.NoError(t, tester.IdentityCall(1, Multisig2Contract, "approve", common.ToBytes(uint64(1))))
+	tester.Commit()
+	require.NoError(t, tester.IdentityCall(0, Multisig2Contract, "execute", common.ToBytes(uint64(1))))
+	tester.Commit()
+	require.Equal(t, big.NewInt(100), tester.appState.State.GetBalance(dest))
+	require.Equal(t, big.NewInt(900), tester.appState.State.GetBalance(tester.contractAddr))
+	require.Error(t, tester.IdentityCall(1, Multisig2Contract, "approve", common.ToBytes(uint64(1))))
+
+	require.NoError(t, tester.IdentityCall(0, Multisig2Contract, "proposeThreshold", []byte{3}))
+	tester.Commit()
+	tester.height = 14
+	require.Error(t, tester.IdentityCall(1, Multisig2Contract, "approve", common.ToBytes(uint64(3))))
+	data, _ = tester.Read(Multisig2Contract, "proposals")
+	require.Empty(t, data)
+	data, _ = tester.Read(Multisig2Contract, "approvals", common.ToBytes(uint64(3)))
+	require.Len(t, data, common.AddressLength)
+
+	// expired proposals are removed with their approvals on the next proposal
+	require.NoError(t, tester.IdentityCall(1, Multisig2Contract, "proposeThreshold", []byte{1}))
+	tester.Commit()
+	_, err = tester.Read(Multisig2Contract, "proposal", common.ToBytes(uint64(3)))
+	require.Error(t, err)
+	data, _ = tester.Read(Multisig2Contract, "approvals", common.ToBytes(uint64(3)))
+	require.Empty(t, data)
+	data, _ = tester.Read(Multisig2Contract, "proposals")
+	require.Equal(t, common.ToBytes(uint64(4)), data)
+}
+
+func TestMultisig2_lifetimeOverflow(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 3, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	var signers []common.Address
+	for _, key := range tester.identities {
+		signers = append(signers, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	tester.height = 3
+	require.Error(t, tester.ConfigureDeploy(common.DnaBase).Multisig2().SetSigners(signers).SetLifetime(math.MaxUint64-2).Deploy())
+	require.NoError(t, tester.ConfigureDeploy(common.DnaBase).Multisig2().SetSigners(signers).SetLifetime(math.MaxUint64-3).Deploy())
+	tester.Commit()
+
+	require.NoError(t, tester.IdentityCall(0, Multisig2Contract, "proposeThreshold", []byte{1}))
+	tester.Commit()
+	tester.height = 4
+	require.Error(t, tester.IdentityCall(0, Multisig2Contract, "proposeThreshold", []byte{1}))
+}
@@ -0,0 +1,123 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+type configurableMultisigDeploy struct {
+	contractTester *contractTester
+	deployStake    *big.Int
+
+	maxVotes byte
+	minVotes byte
+}
+
+func (c *configurableMultisigDeploy) Parameters() (contract EmbeddedContractType, deployStake *big.Int, params [][]byte) {
+	return MultisigContract, c.deployStake, [][]byte{{c.maxVotes}, {c.minVotes}}
+}
+
+func (c *configurableMultisigDeploy) SetMaxVotes(maxVotes byte) *configurableMultisigDeploy {
+	c.maxVotes = maxVotes
+	return c
+}
+
+func (c *configurableMultisigDeploy) SetMinVotes(minVotes byte) *configurableMultisigDeploy {
+	c.minVotes = minVotes
+	return c
+}
+
+func (c *configurableMultisigDeploy) Deploy() (*multisigCaller, error) {
+	if err := c.contractTester.Deploy(c); err != nil {
+		return nil, err
+	}
+	return &multisigCaller{c.contractTester}, nil
+}
+
+type multisigCaller struct {
+	contractTester *contractTester
+}
+
+func (c *multisigCaller) add(addr common.Address) error {
+	return c.contractTester.OwnerCall(MultisigContract, "add", addr.Bytes())
+}
+
+func (c *multisigCaller) send(identityIndex int, dest common.Address, amount *big.Int) error {
+	return c.contractTester.IdentityCall(identityIndex, MultisigContract, "send", dest.Bytes(), amount.Bytes())
+}
+
+func (c *multisigCaller) read(method string, args ...[]byte) ([]byte, error) {
+	return c.contractTester.Read(MultisigContract, method, args...)
+}
+
+func TestMultisig_Read(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 4, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	caller, err := tester.ConfigureDeploy(common.DnaBase).Multisig().SetMaxVotes(3).SetMinVotes(2).Deploy()
+	require.NoError(t, err)
+	tester.Commit()
+
+	var owners []common.Address
+	for _, key := range tester.identities {
+		owner := crypto.PubkeyToAddress(key.PublicKey)
+		owners = append(owners, owner)
+		require.NoError(t, caller.add(owner))
+		tester.Commit()
+	}
+
+	data, err := caller.read("state")
+	require.NoError(t, err)
+	require.Equal(t, []byte{multisigInitialized}, data)
+
+	data, err = caller.read("minVotes")
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, data)
+
+	data, err = caller.read("maxVotes")
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, data)
+
+	data, err = caller.read("owners")
+	require.NoError(t, err)
+	require.Len(t, data, len(owners)*common.AddressLength)
+	for _, owner := range owners {
+		require.Contains(t, string(data), string(owner.Bytes()))
+	}
+
+	dest := common.Address{0x1}
+	amount := big.NewInt(100)
+	require.NoError(t, caller.send(0, dest, amount))
+	tester.Commit()
+	require.NoError(t, caller.send(1, dest, amount))
+	tester.Commit()
+
+	data, err = caller.read("voteAddress", owners[0].Bytes())
+	require.NoError(t, err)
+	require.Equal(t, dest.Bytes(), data)
+
+	data, err = caller.read("voteAmount", owners[1].Bytes())
+	require.NoError(t, err)
+	require.Equal(t, amount.Bytes(), data)
+
+	data, err = caller.read("voteAddress", owners[2].Bytes())
+	require.NoError(t, err)
+	require.Equal(t, owners[2].Bytes(), data)
+
+	data, err = caller.read("votes", dest.Bytes(), amount.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, common.ToBytes(uint64(2)), data)
+
+	_, err = caller.read("voteAddress", common.Address{0x2}.Bytes())
+	require.Error(t, err)
+
+	_, err = caller.read("unknown")
+	require.Error(t, err)
+}
@@ -466,7 +466,7 @@ func (f *OracleVoting5) finishVoting(args ...[]byte) error {
 					}
 				} else {
 					err = f.env.Send(f.ctx, dest, oracleReward)
-					f.env.Event("reward", dest.Bytes(), oracleReward.Bytes())
+					f.env.Event(f.ctx, "reward", dest.Bytes(), oracleReward.Bytes())
 				}
 			}
 			return err != nil
@@ -488,7 +488,7 @@ func (f *OracleVoting5) finishVoting(args ...[]byte) error {
 			if err != nil {
 				return err
 			}
-			f.env.Event("reward", pool.Bytes(), reward.Bytes())
+			f.env.Event(f.ctx, "reward", pool.Bytes(), reward.Bytes())
 		}
 
 		if ownerReward.Sign() > 0 {
@@ -734,14 +734,14 @@ func (f *OracleVoting5) Terminate(args ...[]byte) (common.Address, [][]byte, err
 					dest := common.Address{}
 					dest.SetBytes(key)
 					err = f.env.Send(f.ctx, dest, oracleReward)
-					f.env.Event("reward", dest.Bytes(), oracleReward.Bytes())
+					f.env.Event(f.ctx, "reward", dest.Bytes(), oracleReward.Bytes())
 					return err != nil
 				})
 				f.voteHashes.Iterate(func(key []byte, value []byte) bool {
 					dest := common.Address{}
 					dest.SetBytes(key)
 					err = f.env.Send(f.ctx, dest, oracleReward)
-					f.env.Event("reward", dest.Bytes(), oracleReward.Bytes())
+					f.env.Event(f.ctx, "reward", dest.Bytes(), oracleReward.Bytes())
 					return err != nil
 				})
 				if err != nil {
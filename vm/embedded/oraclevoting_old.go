@@ -406,7 +406,7 @@ func (f *OracleVoting3) finishVoting(args ...[]byte) error {
 					}
 				} else {
 					err = f.env.Send(f.ctx, dest, oracleReward)
-					f.env.Event("reward", dest.Bytes(), oracleReward.Bytes())
+					f.env.Event(f.ctx, "reward", dest.Bytes(), oracleReward.Bytes())
 				}
 			}
 			return err != nil
@@ -428,7 +428,7 @@ func (f *OracleVoting3) finishVoting(args ...[]byte) error {
 			if err != nil {
 				return err
 			}
-			f.env.Event("reward", pool.Bytes(), reward.Bytes())
+			f.env.Event(f.ctx, "reward", pool.Bytes(), reward.Bytes())
 		}
 
 		if ownerReward.Sign() > 0 {
@@ -672,14 +672,14 @@ func (f *OracleVoting3) Terminate(args ...[]byte) (common.Address, [][]byte, err
 					dest := common.Address{}
 					dest.SetBytes(key)
 					err = f.env.Send(f.ctx, dest, oracleReward)
-					f.env.Event("reward", dest.Bytes(), oracleReward.Bytes())
+					f.env.Event(f.ctx, "reward", dest.Bytes(), oracleReward.Bytes())
 					return err != nil
 				})
 				f.voteHashes.Iterate(func(key []byte, value []byte) bool {
 					dest := common.Address{}
 					dest.SetBytes(key)
 					err = f.env.Send(f.ctx, dest, oracleReward)
-					f.env.Event("reward", dest.Bytes(), oracleReward.Bytes())
+					f.env.Event(f.ctx, "reward", dest.Bytes(), oracleReward.Bytes())
 					return err != nil
 				})
 				if err != nil {
@@ -220,7 +220,7 @@ func (e *RefundableOracleLock2) refund(args ...[]byte) error {
 
 		amount := math2.ToInt(decimal.NewFromBigInt(deposit, 0).Mul(k))
 		err = e.env.Send(e.ctx, dest, amount)
-		e.env.Event("refund", dest.Bytes(), amount.Bytes())
+		e.env.Event(e.ctx, "refund", dest.Bytes(), amount.Bytes())
 		return err != nil
 	})
 	if err != nil {
@@ -44,7 +44,14 @@ func (t *TimeLock) Call(method string, args ...[]byte) (err error) {
 }
 
 func (t *TimeLock) Read(method string, args ...[]byte) ([]byte, error) {
-	panic("implement me")
+	switch method {
+	case "timestamp":
+		return common.ToBytes(t.GetUint64("timestamp")), nil
+	case "owner":
+		return t.Owner().Bytes(), nil
+	default:
+		return nil, errors.New("unknown method")
+	}
 }
 
 func (t *TimeLock) transfer(args ...[]byte) (err error) {
@@ -0,0 +1,51 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+type configurableTimeLockDeploy struct {
+	contractTester *contractTester
+	deployStake    *big.Int
+
+	timestamp uint64
+}
+
+func (c *configurableTimeLockDeploy) Parameters() (contract EmbeddedContractType, deployStake *big.Int, params [][]byte) {
+	return TimeLockContract, c.deployStake, [][]byte{common.ToBytes(c.timestamp)}
+}
+
+func (c *configurableTimeLockDeploy) SetTimestamp(timestamp uint64) *configurableTimeLockDeploy {
+	c.timestamp = timestamp
+	return c
+}
+
+func (c *configurableTimeLockDeploy) Deploy() error {
+	return c.contractTester.Deploy(c)
+}
+
+func TestTimeLock_Read(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 2, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	require.NoError(t, tester.ConfigureDeploy(common.DnaBase).TimeLock().SetTimestamp(100).Deploy())
+	tester.Commit()
+
+	data, err := tester.Read(TimeLockContract, "timestamp")
+	require.NoError(t, err)
+	require.Equal(t, common.ToBytes(uint64(100)), data)
+
+	data, err = tester.Read(TimeLockContract, "owner")
+	require.NoError(t, err)
+	require.Equal(t, tester.mainAddr.Bytes(), data)
+
+	_, err = tester.Read(TimeLockContract, "unknown")
+	require.Error(t, err)
+}
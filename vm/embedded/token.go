@@ -0,0 +1,282 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/idena-network/idena-go/vm/helpers"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+const (
+	maxTokenNameLength   = 64
+	maxTokenSymbolLength = 16
+	maxTokenDecimals     = 36
+)
+
+type FungibleToken struct {
+	*BaseContract
+	balances   *env.Map
+	allowances *env.Map
+}
+
+func NewFungibleToken(ctx env.CallContext, e env.Env, statsCollector collector.StatsCollector) *FungibleToken {
+	return &FungibleToken{&BaseContract{
+		ctx:            ctx,
+		env:            e,
+		statsCollector: statsCollector,
+	}, env.NewMap([]byte("b"), e, ctx), env.NewMap([]byte("a"), e, ctx)}
+}
+
+func (f *FungibleToken) Deploy(args ...[]byte) error {
+	name, err := helpers.ExtractArray(0, args...)
+	if err != nil {
+		return err
+	}
+	if len(name) == 0 || len(name) > maxTokenNameLength {
+		return errors.Errorf("name length should be in range [1;%v]", maxTokenNameLength)
+	}
+	symbol, err := helpers.ExtractArray(1, args...)
+	if err != nil {
+		return err
+	}
+	if len(symbol) == 0 || len(symbol) > maxTokenSymbolLength {
+		return errors.Errorf("symbol length should be in range [1;%v]", maxTokenSymbolLength)
+	}
+	decimals, err := helpers.ExtractByte(2, args...)
+	if err != nil {
+		return err
+	}
+	if decimals > maxTokenDecimals {
+		return errors.Errorf("decimals should be in range [0;%v]", maxTokenDecimals)
+	}
+	supply, err := helpers.ExtractBigInt(3, args...)
+	if err != nil {
+		return err
+	}
+	f.SetArray("name", name)
+	f.SetArray("symbol", symbol)
+	f.SetByte("decimals", decimals)
+	f.SetOwner(f.ctx.Sender())
+	if supply.Sign() > 0 {
+		f.mintTo(f.ctx.Sender(), supply)
+	}
+	return nil
+}
+
+func (f *FungibleToken) Call(method string, args ...[]byte) error {
+	switch method {
+	case "transfer":
+		return f.transfer(args...)
+	case "approve":
+		return f.approve(args...)
+	case "transferFrom":
+		return f.transferFrom(args...)
+	case "mint":
+		return f.mint(args...)
+	case "burn":
+		return f.burn(args...)
+	default:
+		return errors.New("unknown method")
+	}
+}
+
+func (f *FungibleToken) Read(method string, args ...[]byte) ([]byte, error) {
+	switch method {
+	case "name":
+		return f.GetArray("name"), nil
+	case "symbol":
+		return f.GetArray("symbol"), nil
+	case "decimals":
+		return []byte{f.GetByte("decimals")}, nil
+	case "totalSupply":
+		return f.totalSupply().Bytes(), nil
+	case "balanceOf":
+		addr, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		return f.balanceOf(addr).Bytes(), nil
+	case "allowance":
+		owner, err := helpers.ExtractAddr(0, args...)
+		if err != nil {
+			return nil, err
+		}
+		spender, err := helpers.ExtractAddr(1, args...)
+		if err != nil {
+			return nil, err
+		}
+		return f.allowance(owner, spender).Bytes(), nil
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
+
+func (f *FungibleToken) transfer(args ...[]byte) error {
+	dest, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return err
+	}
+	amount, err := helpers.ExtractBigInt(1, args...)
+	if err != nil {
+		return err
+	}
+	return f.move(f.ctx.Sender(), dest, amount)
+}
+
+func (f *FungibleToken) approve(args ...[]byte) error {
+	spender, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return err
+	}
+	amount, err := helpers.ExtractBigInt(1, args...)
+	if err != nil {
+		return err
+	}
+	owner := f.ctx.Sender()
+	f.setAllowance(owner, spender, amount)
+	f.env.Event(f.ctx, "approval", owner.Bytes(), spender.Bytes(), amount.Bytes())
+	return nil
+}
+
+func (f *FungibleToken) transferFrom(args ...[]byte) error {
+	from, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return err
+	}
+	dest, err := helpers.ExtractAddr(1, args...)
+	if err != nil {
+		return err
+	}
+	amount, err := helpers.ExtractBigInt(2, args...)
+	if err != nil {
+		return err
+	}
+	spender := f.ctx.Sender()
+	allowance := f.allowance(from, spender)
+	if allowance.Cmp(amount) < 0 {
+		return errors.New("insufficient allowance")
+	}
+	if err := f.move(from, dest, amount); err != nil {
+		return err
+	}
+	f.setAllowance(from, spender, new(big.Int).Sub(allowance, amount))
+	return nil
+}
+
+func (f *FungibleToken) mint(args ...[]byte) error {
+	if !f.IsOwner() {
+		return errors.New("sender is not an owner")
+	}
+	dest, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return err
+	}
+	amount, err := helpers.ExtractBigInt(1, args...)
+	if err != nil {
+		return err
+	}
+	if amount.Sign() <= 0 {
+		return errors.New("amount should be positive")
+	}
+	if dest.IsEmpty() {
+		return errors.New("destination is empty")
+	}
+	f.mintTo(dest, amount)
+	return nil
+}
+
+// burn destroys tokens from the owner balance
+func (f *FungibleToken) burn(args ...[]byte) error {
+	if !f.IsOwner() {
+		return errors.New("sender is not an owner")
+	}
+	amount, err := helpers.ExtractBigInt(0, args...)
+	if err != nil {
+		return err
+	}
+	if amount.Sign() <= 0 {
+		return errors.New("amount should be positive")
+	}
+	owner := f.ctx.Sender()
+	balance := f.balanceOf(owner)
+	if balance.Cmp(amount) < 0 {
+		return errors.New("insufficient balance")
+	}
+	f.setBalance(owner, new(big.Int).Sub(balance, amount))
+	f.SetBigInt("totalSupply", new(big.Int).Sub(f.totalSupply(), amount))
+	f.env.Event(f.ctx, "transfer", owner.Bytes(), common.Address{}.Bytes(), amount.Bytes())
+	return nil
+}
+
+func (f *FungibleToken) mintTo(dest common.Address, amount *big.Int) {
+	f.setBalance(dest, new(big.Int).Add(f.balanceOf(dest), amount))
+	f.SetBigInt("totalSupply", new(big.Int).Add(f.totalSupply(), amount))
+	f.env.Event(f.ctx, "transfer", common.Address{}.Bytes(), dest.Bytes(), amount.Bytes())
+}
+
+func (f *FungibleToken) move(from, dest common.Address, amount *big.Int) error {
+	if amount.Sign() <= 0 {
+		return errors.New("amount should be positive")
+	}
+	if dest.IsEmpty() {
+		return errors.New("destination is empty")
+	}
+	balance := f.balanceOf(from)
+	if balance.Cmp(amount) < 0 {
+		return errors.New("insufficient balance")
+	}
+	f.setBalance(from, new(big.Int).Sub(balance, amount))
+	f.setBalance(dest, new(big.Int).Add(f.balanceOf(dest), amount))
+	f.env.Event(f.ctx, "transfer", from.Bytes(), dest.Bytes(), amount.Bytes())
+	return nil
+}
+
+func (f *FungibleToken) totalSupply() *big.Int {
+	if supply := f.GetBigInt("totalSupply"); supply != nil {
+		return supply
+	}
+	return new(big.Int)
+}
+
+func (f *FungibleToken) balanceOf(addr common.Address) *big.Int {
+	return new(big.Int).SetBytes(f.balances.Get(addr.Bytes()))
+}
+
+func (f *FungibleToken) setBalance(addr common.Address, amount *big.Int) {
+	if amount.Sign() == 0 {
+		f.balances.Remove(addr.Bytes())
+		return
+	}
+	f.balances.Set(addr.Bytes(), amount.Bytes())
+}
+
+// allowanceKey fits the owner and spender pair into the contract store key length
+func allowanceKey(owner, spender common.Address) []byte {
+	return crypto.Keccak256(owner.Bytes(), spender.Bytes())[:common.MaxContractStoreKeyLength-1]
+}
+
+func (f *FungibleToken) allowance(owner, spender common.Address) *big.Int {
+	return new(big.Int).SetBytes(f.allowances.Get(allowanceKey(owner, spender)))
+}
+
+func (f *FungibleToken) setAllowance(owner, spender common.Address, amount *big.Int) {
+	if amount.Sign() == 0 {
+		f.allowances.Remove(allowanceKey(owner, spender))
+		return
+	}
+	f.allowances.Set(allowanceKey(owner, spender), amount.Bytes())
+}
+
+// Terminate is allowed for the owner only after all tokens are burnt
+func (f *FungibleToken) Terminate(args ...[]byte) (common.Address, [][]byte, error) {
+	if !f.IsOwner() {
+		return common.Address{}, nil, errors.New("sender is not an owner")
+	}
+	if f.totalSupply().Sign() > 0 {
+		return common.Address{}, nil, errors.New("token supply is not zero")
+	}
+	return f.Owner(), nil, nil
+}
@@ -0,0 +1,122 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+type configurableFungibleTokenDeploy struct {
+	contractTester *contractTester
+	deployStake    *big.Int
+
+	name     string
+	symbol   string
+	decimals byte
+	supply   *big.Int
+}
+
+func (c *configurableFungibleTokenDeploy) Parameters() (contract EmbeddedContractType, deployStake *big.Int, params [][]byte) {
+	return FungibleTokenContract, c.deployStake, [][]byte{[]byte(c.name), []byte(c.symbol), {c.decimals}, c.supply.Bytes()}
+}
+
+func (c *configurableFungibleTokenDeploy) SetSupply(supply *big.Int) *configurableFungibleTokenDeploy {
+	c.supply = supply
+	return c
+}
+
+func (c *configurableFungibleTokenDeploy) Deploy() (*fungibleTokenCaller, error) {
+	if err := c.contractTester.Deploy(c); err != nil {
+		return nil, err
+	}
+	return &fungibleTokenCaller{c.contractTester}, nil
+}
+
+func (s *deployContractSwitch) FungibleToken() *configurableFungibleTokenDeploy {
+	return &configurableFungibleTokenDeploy{
+		contractTester: s.contractTester,
+		deployStake:    s.deployStake,
+		name:           "Test token",
+		symbol:         "TST",
+		decimals:       18,
+		supply:         big.NewInt(1000),
+	}
+}
+
+type fungibleTokenCaller struct {
+	contractTester *contractTester
+}
+
+func (c *fungibleTokenCaller) balanceOf(addr common.Address) *big.Int {
+	data, _ := c.contractTester.Read(FungibleTokenContract, "balanceOf", addr.Bytes())
+	return new(big.Int).SetBytes(data)
+}
+
+func (c *fungibleTokenCaller) totalSupply() *big.Int {
+	data, _ := c.contractTester.Read(FungibleTokenContract, "totalSupply")
+	return new(big.Int).SetBytes(data)
+}
+
+func TestFungibleToken(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 3, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	caller, err := tester.ConfigureDeploy(common.DnaBase).FungibleToken().SetSupply(big.NewInt(1000)).Deploy()
+	require.NoError(t, err)
+	require.Len(t, tester.Commit(), 1)
+
+	owner := tester.mainAddr
+	addr1 := crypto.PubkeyToAddress(tester.identities[0].PublicKey)
+	addr2 := crypto.PubkeyToAddress(tester.identities[1].PublicKey)
+
+	data, err := tester.Read(FungibleTokenContract, "symbol")
+	require.NoError(t, err)
+	require.Equal(t, "TST", string(data))
+	require.Equal(t, big.NewInt(1000), caller.balanceOf(owner))
+	require.Equal(t, big.NewInt(1000), caller.totalSupply())
+
+	require.NoError(t, tester.OwnerCall(FungibleTokenContract, "transfer", addr1.Bytes(), big.NewInt(300).Bytes()))
+	events := tester.Commit()
+	require.Len(t, events, 1)
+	require.Equal(t, "transfer", events[0].EventName)
+	require.Equal(t, big.NewInt(700), caller.balanceOf(owner))
+	require.Equal(t, big.NewInt(300), caller.balanceOf(addr1))
+
+	require.Error(t, tester.IdentityCall(0, FungibleTokenContract, "transfer", addr2.Bytes(), big.NewInt(301).Bytes()))
+
+	require.NoError(t, tester.IdentityCall(0, FungibleTokenContract, "approve", addr2.Bytes(), big.NewInt(100).Bytes()))
+	tester.Commit()
+	data, err = tester.Read(FungibleTokenContract, "allowance", addr1.Bytes(), addr2.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), new(big.Int).SetBytes(data))
+
+	require.Error(t, tester.IdentityCall(1, FungibleTokenContract, "transferFrom", addr1.Bytes(), addr2.Bytes(), big.NewInt(101).Bytes()))
+	require.NoError(t, tester.IdentityCall(1, FungibleTokenContract, "transferFrom", addr1.Bytes(), addr2.Bytes(), big.NewInt(60).Bytes()))
+	tester.Commit()
+	require.Equal(t, big.NewInt(240), caller.balanceOf(addr1))
+	require.Equal(t, big.NewInt(60), caller.balanceOf(addr2))
+	data, _ = tester.Read(FungibleTokenContract, "allowance", addr1.Bytes(), addr2.Bytes())
+	require.Equal(t, big.NewInt(40), new(big.Int).SetBytes(data))
+
+	require.Error(t, tester.IdentityCall(0, FungibleTokenContract, "mint", addr1.Bytes(), big.NewInt(10).Bytes()))
+	require.NoError(t, tester.OwnerCall(FungibleTokenContract, "mint", addr2.Bytes(), big.NewInt(40).Bytes()))
+	tester.Commit()
+	require.Equal(t, big.NewInt(100), caller.balanceOf(addr2))
+	require.Equal(t, big.NewInt(1040), caller.totalSupply())
+
+	require.Error(t, tester.IdentityCall(0, FungibleTokenContract, "burn", big.NewInt(10).Bytes()))
+	require.Error(t, tester.OwnerCall(FungibleTokenContract, "burn", big.NewInt(701).Bytes()))
+	require.NoError(t, tester.OwnerCall(FungibleTokenContract, "burn", big.NewInt(700).Bytes()))
+	tester.Commit()
+	require.Equal(t, big.NewInt(340), caller.totalSupply())
+	require.Nil(t, tester.ReadData("b"+string(owner.Bytes())))
+
+	_, err = tester.Terminate(tester.mainKey, FungibleTokenContract)
+	require.Error(t, err)
+}
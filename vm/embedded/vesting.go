@@ -0,0 +1,202 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/idena-network/idena-go/vm/helpers"
+	"github.com/pkg/errors"
+	"math"
+	"math/big"
+)
+
+// Vesting releases coins sent to the contract to the beneficiary linearly from the start time during the duration,
+// nothing is released before the cliff. The owner is a grantor, it can revoke the unvested part if the contract is revocable.
+type Vesting struct {
+	*BaseContract
+}
+
+func NewVesting(ctx env.CallContext, e env.Env, statsCollector collector.StatsCollector) *Vesting {
+	return &Vesting{
+		&BaseContract{
+			ctx:            ctx,
+			env:            e,
+			statsCollector: statsCollector,
+		},
+	}
+}
+
+func (v *Vesting) Deploy(args ...[]byte) error {
+	beneficiary, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return err
+	}
+	start, err := helpers.ExtractUInt64(1, args...)
+	if err != nil {
+		return err
+	}
+	cliff, err := helpers.ExtractUInt64(2, args...)
+	if err != nil {
+		return err
+	}
+	duration, err := helpers.ExtractUInt64(3, args...)
+	if err != nil {
+		return err
+	}
+	if duration == 0 {
+		return errors.New("duration should be positive")
+	}
+	if cliff > duration {
+		return errors.New("cliff should not exceed duration")
+	}
+	if start > math.MaxUint64-duration {
+		return errors.New("start+duration overflows")
+	}
+	var revocable byte
+	if value, err := helpers.ExtractByte(4, args...); err == nil && value > 0 {
+		revocable = 1
+	}
+	v.SetArray("beneficiary", beneficiary.Bytes())
+	v.SetUint64("start", start)
+	v.SetUint64("cliff", cliff)
+	v.SetUint64("duration", duration)
+	v.SetByte("revocable", revocable)
+	v.SetOwner(v.ctx.Sender())
+	return nil
+}
+
+func (v *Vesting) Call(method string, args ...[]byte) error {
+	switch method {
+	case "withdraw":
+		return v.withdraw()
+	case "revoke":
+		return v.revoke()
+	default:
+		return errors.New("unknown method")
+	}
+}
+
+func (v *Vesting) Read(method string, args ...[]byte) ([]byte, error) {
+	switch method {
+	case "beneficiary":
+		return v.GetArray("beneficiary"), nil
+	case "owner":
+		return v.Owner().Bytes(), nil
+	case "start":
+		return common.ToBytes(v.GetUint64("start")), nil
+	case "cliff":
+		return common.ToBytes(v.GetUint64("cliff")), nil
+	case "duration":
+		return common.ToBytes(v.GetUint64("duration")), nil
+	case "revocable":
+		return []byte{v.GetByte("revocable")}, nil
+	case "revoked":
+		return []byte{v.GetByte("revoked")}, nil
+	case "vested":
+		return v.vested().Bytes(), nil
+	case "withdrawn":
+		return v.withdrawn().Bytes(), nil
+	case "releasable":
+		return v.releasable().Bytes(), nil
+	default:
+		return nil, errors.New("unknown method")
+	}
+}
+
+func (v *Vesting) beneficiary() common.Address {
+	var addr common.Address
+	addr.SetBytes(v.GetArray("beneficiary"))
+	return addr
+}
+
+func (v *Vesting) withdrawn() *big.Int {
+	if withdrawn := v.GetBigInt("withdrawn"); withdrawn != nil {
+		return withdrawn
+	}
+	return new(big.Int)
+}
+
+// vested returns the amount released to the beneficiary by the current block time including withdrawn coins
+func (v *Vesting) vested() *big.Int {
+	if v.GetByte("revoked") == 1 {
+		if vested := v.GetBigInt("revokedVested"); vested != nil {
+			return vested
+		}
+		return new(big.Int)
+	}
+	total := new(big.Int).Add(v.env.Balance(v.ctx.ContractAddr()), v.withdrawn())
+	start, cliff, duration := v.GetUint64("start"), v.GetUint64("cliff"), v.GetUint64("duration")
+	now := uint64(v.env.BlockTimeStamp())
+	if now < start+cliff {
+		return new(big.Int)
+	}
+	if now >= start+duration {
+		return total
+	}
+	vested := new(big.Int).Mul(total, new(big.Int).SetUint64(now-start))
+	return vested.Div(vested, new(big.Int).SetUint64(duration))
+}
+
+func (v *Vesting) releasable() *big.Int {
+	return new(big.Int).Sub(v.vested(), v.withdrawn())
+}
+
+func (v *Vesting) withdraw() error {
+	beneficiary := v.beneficiary()
+	if v.ctx.Sender() != beneficiary {
+		return errors.New("sender is not a beneficiary")
+	}
+	amount := v.releasable()
+	if amount.Sign() <= 0 {
+		return errors.New("nothing to withdraw")
+	}
+	if err := v.env.Send(v.ctx, beneficiary, amount); err != nil {
+		return err
+	}
+	v.SetBigInt("withdrawn", new(big.Int).Add(v.withdrawn(), amount))
+	v.env.Event(v.ctx, "withdraw", beneficiary.Bytes(), amount.Bytes())
+	return nil
+}
+
+// revoke fixes the vested amount and returns the rest of coins to the owner
+func (v *Vesting) revoke() error {
+	if !v.IsOwner() {
+		return errors.New("sender is not an owner")
+	}
+	if v.GetByte("revocable") != 1 {
+		return errors.New("contract is not revocable")
+	}
+	if v.GetByte("revoked") == 1 {
+		return errors.New("contract is revoked")
+	}
+	vested := v.vested()
+	unvested := new(big.Int).Sub(v.env.Balance(v.ctx.ContractAddr()), new(big.Int).Sub(vested, v.withdrawn()))
+	if unvested.Sign() > 0 {
+		if err := v.env.Send(v.ctx, v.Owner(), unvested); err != nil {
+			return err
+		}
+	}
+	v.SetBigInt("revokedVested", vested)
+	v.SetByte("revoked", 1)
+	v.env.Event(v.ctx, "revoke", v.Owner().Bytes(), unvested.Bytes())
+	return nil
+}
+
+func (v *Vesting) Terminate(args ...[]byte) (common.Address, [][]byte, error) {
+	if !v.IsOwner() {
+		return common.Address{}, nil, errors.New("sender is not an owner")
+	}
+	balance := v.env.Balance(v.ctx.ContractAddr())
+	dust := big.NewInt(0).Mul(v.env.MinFeePerGas(), big.NewInt(100))
+	if balance.Cmp(dust) > 0 {
+		return common.Address{}, nil, errors.New("contract has dna")
+	}
+	if balance.Sign() > 0 {
+		v.env.BurnAll(v.ctx)
+	}
+	dest, err := helpers.ExtractAddr(0, args...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return dest, nil, nil
+}
@@ -0,0 +1,146 @@
+package embedded
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/big"
+	"testing"
+)
+
+type configurableVestingDeploy struct {
+	contractTester *contractTester
+	deployStake    *big.Int
+
+	beneficiary common.Address
+	start       uint64
+	cliff       uint64
+	duration    uint64
+	revocable   bool
+}
+
+func (c *configurableVestingDeploy) Parameters() (contract EmbeddedContractType, deployStake *big.Int, params [][]byte) {
+	var revocable byte
+	if c.revocable {
+		revocable = 1
+	}
+	return VestingContract, c.deployStake, [][]byte{c.beneficiary.Bytes(), common.ToBytes(c.start), common.ToBytes(c.cliff),
+		common.ToBytes(c.duration), {revocable}}
+}
+
+func (c *configurableVestingDeploy) SetBeneficiary(beneficiary common.Address) *configurableVestingDeploy {
+	c.beneficiary = beneficiary
+	return c
+}
+
+func (c *configurableVestingDeploy) SetSchedule(start, cliff, duration uint64) *configurableVestingDeploy {
+	c.start, c.cliff, c.duration = start, cliff, duration
+	return c
+}
+
+func (c *configurableVestingDeploy) SetRevocable(revocable bool) *configurableVestingDeploy {
+	c.revocable = revocable
+	return c
+}
+
+func (c *configurableVestingDeploy) Deploy() error {
+	return c.contractTester.Deploy(c)
+}
+
+func (s *deployContractSwitch) Vesting() *configurableVestingDeploy {
+	return &configurableVestingDeploy{
+		contractTester: s.contractTester,
+		deployStake:    s.deployStake,
+		start:          100,
+		cliff:          50,
+		duration:       200,
+	}
+}
+
+func readVestingAmount(t *testing.T, tester *contractTester, method string) *big.Int {
+	data, err := tester.Read(VestingContract, method)
+	require.NoError(t, err)
+	return new(big.Int).SetBytes(data)
+}
+
+func TestVesting_withdraw(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 2, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	beneficiary := crypto.PubkeyToAddress(tester.identities[0].PublicKey)
+	require.NoError(t, tester.ConfigureDeploy(common.DnaBase).Vesting().SetBeneficiary(beneficiary).Deploy())
+	tester.Commit()
+	tester.AddBalance(big.NewInt(1000))
+
+	tester.timestamp = 149
+	require.Error(t, tester.IdentityCall(0, VestingContract, "withdraw"))
+	require.Zero(t, readVestingAmount(t, tester, "vested").Sign())
+
+	tester.timestamp = 150
+	require.Error(t, tester.OwnerCall(VestingContract, "withdraw"))
+	require.NoError(t, tester.IdentityCall(0, VestingContract, "withdraw"))
+	tester.Commit()
+	require.Equal(t, big.NewInt(250), tester.appState.State.GetBalance(beneficiary))
+	require.Equal(t, big.NewInt(250), readVestingAmount(t, tester, "withdrawn"))
+	require.Zero(t, readVestingAmount(t, tester, "releasable").Sign())
+
+	tester.timestamp = 200
+	require.Error(t, tester.OwnerCall(VestingContract, "revoke"))
+	require.Equal(t, big.NewInt(500), readVestingAmount(t, tester, "vested"))
+	require.Equal(t, big.NewInt(250), readVestingAmount(t, tester, "releasable"))
+
+	tester.timestamp = 300
+	require.NoError(t, tester.IdentityCall(0, VestingContract, "withdraw"))
+	tester.Commit()
+	require.Equal(t, big.NewInt(1000), tester.appState.State.GetBalance(beneficiary))
+	require.Zero(t, tester.appState.State.GetBalance(tester.contractAddr).Sign())
+
+	_, err := tester.Terminate(tester.mainKey, VestingContract)
+	require.Error(t, err)
+}
+
+func TestVesting_revoke(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 2, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	beneficiary := crypto.PubkeyToAddress(tester.identities[0].PublicKey)
+	require.NoError(t, tester.ConfigureDeploy(big.NewInt(0)).Vesting().SetBeneficiary(beneficiary).SetRevocable(true).Deploy())
+	tester.Commit()
+	tester.AddBalance(big.NewInt(1000))
+
+	tester.timestamp = 200
+	require.Error(t, tester.IdentityCall(0, VestingContract, "revoke"))
+	require.NoError(t, tester.OwnerCall(VestingContract, "revoke"))
+	tester.Commit()
+	require.Equal(t, big.NewInt(500), tester.appState.State.GetBalance(tester.contractAddr))
+	require.Equal(t, new(big.Int).Add(common.DnaBase, big.NewInt(500)), tester.appState.State.GetBalance(tester.mainAddr))
+	require.Error(t, tester.OwnerCall(VestingContract, "revoke"))
+
+	tester.timestamp = 300
+	require.NoError(t, tester.IdentityCall(0, VestingContract, "withdraw"))
+	tester.Commit()
+	require.Equal(t, big.NewInt(500), readVestingAmount(t, tester, "vested"))
+	require.Equal(t, big.NewInt(500), tester.appState.State.GetBalance(beneficiary))
+	require.Error(t, tester.IdentityCall(0, VestingContract, "withdraw"))
+}
+
+func TestVesting_Deploy_overflow(t *testing.T) {
+	builder := createTestContractBuilder(&networkConfig{
+		identityGroups: []identityGroupConfig{
+			{count: 2, state: state.Verified},
+		},
+	}, common.DnaBase)
+	tester := builder.Build()
+	beneficiary := crypto.PubkeyToAddress(tester.identities[0].PublicKey)
+	require.Error(t, tester.ConfigureDeploy(big.NewInt(0)).Vesting().SetBeneficiary(beneficiary).SetSchedule(math.MaxUint64-100, 50, 200).Deploy())
+	require.Error(t, tester.ConfigureDeploy(big.NewInt(0)).Vesting().SetBeneficiary(beneficiary).SetSchedule(100, 50, math.MaxUint64).Deploy())
+	require.NoError(t, tester.ConfigureDeploy(big.NewInt(0)).Vesting().SetBeneficiary(beneficiary).SetSchedule(math.MaxUint64-200, 50, 200).Deploy())
+}
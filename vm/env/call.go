@@ -0,0 +1,206 @@
+package env
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+const MaxCallDepth = 8
+
+// NestedContract is an embedded contract which can be called by another contract
+type NestedContract interface {
+	Call(method string, args ...[]byte) error
+	Read(method string, args ...[]byte) ([]byte, error)
+}
+
+// ContractFactory creates an embedded contract for the context, it returns nil for unknown contracts
+type ContractFactory func(ctx CallContext) NestedContract
+
+// NestedCallContext is a context of a contract called by another contract, the calling contract is the sender
+type NestedCallContext struct {
+	parent   CallContext
+	contract common.Address
+	codeHash common.Hash
+	amount   *big.Int
+}
+
+func (c *NestedCallContext) Sender() common.Address {
+	return c.parent.ContractAddr()
+}
+
+func (c *NestedCallContext) ContractAddr() common.Address {
+	return c.contract
+}
+
+func (c *NestedCallContext) Epoch() uint16 {
+	return c.parent.Epoch()
+}
+
+func (c *NestedCallContext) Nonce() uint32 {
+	return c.parent.Nonce()
+}
+
+func (c *NestedCallContext) PayAmount() *big.Int {
+	return c.amount
+}
+
+func (c *NestedCallContext) CodeHash() common.Hash {
+	return c.codeHash
+}
+
+type envSnapshot struct {
+	contractStoreCache    map[common.Address]map[string]*contractValue
+	balancesCache         map[common.Address]*big.Int
+	deployedContractCache map[common.Address]*state.ContractData
+	droppedContracts      map[common.Address]struct{}
+	eventsCount           int
+	contractStakeCache    map[common.Address]*big.Int
+	collectorCallsCount   int
+}
+
+// snapshot copies caches of the env, values of caches are replaced rather than modified, so copying of maps is enough
+func (e *EnvImp) snapshot() *envSnapshot {
+	s := &envSnapshot{
+		contractStoreCache:    make(map[common.Address]map[string]*contractValue, len(e.contractStoreCache)),
+		balancesCache:         make(map[common.Address]*big.Int, len(e.balancesCache)),
+		deployedContractCache: make(map[common.Address]*state.ContractData, len(e.deployedContractCache)),
+		droppedContracts:      make(map[common.Address]struct{}, len(e.droppedContracts)),
+		eventsCount:           len(e.events),
+		contractStakeCache:    make(map[common.Address]*big.Int, len(e.contractStakeCache)),
+		collectorCallsCount:   len(e.collectorCalls),
+	}
+	for addr, cache := range e.contractStoreCache {
+		copied := make(map[string]*contractValue, len(cache))
+		for k, v := range cache {
+			copied[k] = v
+		}
+		s.contractStoreCache[addr] = copied
+	}
+	for addr, balance := range e.balancesCache {
+		s.balancesCache[addr] = balance
+	}
+	for addr, data := range e.deployedContractCache {
+		// stake of deployed contract is modified by MoveToStake
+		copied := *data
+		s.deployedContractCache[addr] = &copied
+	}
+	for addr := range e.droppedContracts {
+		s.droppedContracts[addr] = struct{}{}
+	}
+	for addr, stake := range e.contractStakeCache {
+		s.contractStakeCache[addr] = stake
+	}
+	return s
+}
+
+func (e *EnvImp) revert(s *envSnapshot) {
+	e.contractStoreCache = s.contractStoreCache
+	e.balancesCache = s.balancesCache
+	e.deployedContractCache = s.deployedContractCache
+	e.droppedContracts = s.droppedContracts
+	e.events = e.events[:s.eventsCount]
+	e.contractStakeCache = s.contractStakeCache
+	e.collectorCalls = e.collectorCalls[:s.collectorCallsCount]
+}
+
+func (e *EnvImp) codeHash(contract common.Address) *common.Hash {
+	if _, ok := e.droppedContracts[contract]; ok {
+		return nil
+	}
+	if data, ok := e.deployedContractCache[contract]; ok {
+		return &data.CodeHash
+	}
+	return e.state.State.GetCodeHash(contract)
+}
+
+func (e *EnvImp) nestedContract(ctx CallContext, contract common.Address, amount *big.Int) (NestedContract, error) {
+	if e.contractFactory == nil {
+		return nil, errors.New("contract calls are not supported")
+	}
+	if e.callDepth >= MaxCallDepth {
+		return nil, errors.New("call depth limit is exceeded")
+	}
+	codeHash := e.codeHash(contract)
+	if codeHash == nil {
+		return nil, errors.New("destination is not a contract")
+	}
+	nested := e.contractFactory(&NestedCallContext{parent: ctx, contract: contract, codeHash: *codeHash, amount: amount})
+	if nested == nil {
+		return nil, errors.New("unknown contract")
+	}
+	return nested, nil
+}
+
+// Call calls the method of another contract on behalf of the calling contract and transfers the amount to it.
+// All changes made by the called contract, including its events and stats collector calls, are reverted if it fails.
+// Gas is charged from the shared gas counter.
+func (e *EnvImp) Call(ctx CallContext, contract common.Address, method string, amount *big.Int, args ...[]byte) (err error) {
+	usedGas := e.gasCounter.UsedGas
+	e.gasCounter.AddGas(100)
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	if amount.Sign() < 0 {
+		return errors.New("value must be non-negative")
+	}
+	nested, err := e.nestedContract(ctx, contract, amount)
+	if err != nil {
+		return err
+	}
+	e.traceOp(&TraceOp{Op: TraceCall, Contract: ctx.ContractAddr(), Dest: &contract, Method: method, Amount: amount, Args: args}, usedGas)
+
+	snapshot := e.snapshot()
+	e.callDepth++
+	defer func() {
+		e.callDepth--
+		if r := recover(); r != nil {
+			if e.gasCounter.limitExceeded() {
+				panic(r)
+			}
+			err = errors.New(fmt.Sprint(r))
+		}
+		if err != nil {
+			e.revert(snapshot)
+			return
+		}
+		if e.callDepth == 0 {
+			for _, f := range e.collectorCalls {
+				f()
+			}
+			e.collectorCalls = nil
+		}
+	}()
+	if amount.Sign() > 0 {
+		if e.getBalance(ctx.ContractAddr()).Cmp(amount) < 0 {
+			return errors.New("insufficient funds")
+		}
+		e.subBalance(ctx.ContractAddr(), amount)
+		e.addBalance(contract, amount)
+	}
+	return nested.Call(method, args...)
+}
+
+// Read calls the read method of another contract
+func (e *EnvImp) Read(ctx CallContext, contract common.Address, method string, args ...[]byte) (data []byte, err error) {
+	usedGas := e.gasCounter.UsedGas
+	e.gasCounter.AddGas(50)
+	nested, err := e.nestedContract(ctx, contract, new(big.Int))
+	if err != nil {
+		return nil, err
+	}
+	e.traceOp(&TraceOp{Op: TraceRead, Contract: ctx.ContractAddr(), Dest: &contract, Method: method, Args: args}, usedGas)
+	e.callDepth++
+	defer func() {
+		e.callDepth--
+		if r := recover(); r != nil {
+			if e.gasCounter.limitExceeded() {
+				panic(r)
+			}
+			err = errors.New(fmt.Sprint(r))
+		}
+	}()
+	return nested.Read(method, args...)
+}
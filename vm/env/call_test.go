@@ -0,0 +1,152 @@
+package env
+
+import (
+	"errors"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/stretchr/testify/require"
+	db2 "github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+var testNestedCodeHash = common.Hash{0xff}
+
+type testNestedContract struct {
+	ctx CallContext
+	env *EnvImp
+}
+
+func (c *testNestedContract) Call(method string, args ...[]byte) error {
+	c.env.SetValue(c.ctx, []byte("sender"), c.ctx.Sender().Bytes())
+	c.env.Event(c.ctx, method)
+	switch method {
+	case "set":
+		return nil
+	case "fail":
+		return errors.New("failed")
+	case "panic":
+		panic("panic")
+	case "recurse":
+		return c.env.Call(c.ctx, c.ctx.ContractAddr(), "recurse", nil)
+	case "send":
+		return c.env.Send(c.ctx, common.BytesToAddress(args[0]), c.ctx.PayAmount())
+	case "callFail":
+		if err := c.env.Call(c.ctx, common.BytesToAddress(args[0]), "fail", nil); err == nil {
+			return errors.New("error is expected")
+		}
+		return nil
+	}
+	return errors.New("unknown method")
+}
+
+func (c *testNestedContract) Read(method string, args ...[]byte) ([]byte, error) {
+	return c.ctx.Sender().Bytes(), nil
+}
+
+func TestEnvImp_Call(t *testing.T) {
+	appState, _ := appstate.NewAppState(db2.NewMemDB(), eventbus.New())
+	caller := common.Address{0x1}
+	callee := common.Address{0x2}
+	receiver := common.Address{0x3}
+	third := common.Address{0x4}
+	appState.State.DeployContract(third, testNestedCodeHash, big.NewInt(0))
+	appState.State.DeployContract(caller, testNestedCodeHash, big.NewInt(0))
+	appState.State.DeployContract(callee, testNestedCodeHash, big.NewInt(0))
+	appState.State.SetBalance(caller, big.NewInt(100))
+
+	gas := &GasCounter{gasLimit: -1}
+	env := NewEnvImp(appState, &types.Header{ProposedHeader: &types.ProposedHeader{Height: 2}}, gas, nil)
+	ctx := &ReadContextImpl{Contract: caller, Hash: testNestedCodeHash}
+
+	require.Error(t, env.Call(ctx, callee, "set", nil))
+
+	env.SetContractFactory(func(ctx CallContext) NestedContract {
+		if ctx.CodeHash() != testNestedCodeHash {
+			return nil
+		}
+		return &testNestedContract{ctx: ctx, env: env}
+	})
+
+	require.Error(t, env.Call(ctx, receiver, "set", nil))
+
+	require.NoError(t, env.Call(ctx, callee, "send", big.NewInt(30), receiver.Bytes()))
+	require.Equal(t, caller.Bytes(), env.GetValue(&ReadContextImpl{Contract: callee}, []byte("sender")))
+	require.Equal(t, big.NewInt(70), env.Balance(caller))
+	require.Zero(t, env.Balance(callee).Sign())
+	require.Equal(t, big.NewInt(30), env.Balance(receiver))
+	require.Len(t, env.events, 1)
+	require.Equal(t, callee, env.events[0].Contract)
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		require.Error(t, env.Call(ctx, callee, "set", big.NewInt(71)))
+		require.Equal(t, big.NewInt(70), env.Balance(caller))
+		require.Len(t, env.events, 1)
+	})
+
+	t.Run("rollback on failure", func(t *testing.T) {
+		for _, method := range []string{"fail", "panic", "recurse"} {
+			require.Error(t, env.Call(ctx, callee, method, big.NewInt(10)))
+			require.Equal(t, big.NewInt(70), env.Balance(caller))
+			require.Zero(t, env.Balance(callee).Sign())
+			require.Len(t, env.events, 1)
+			require.Zero(t, env.callDepth)
+		}
+	})
+
+	t.Run("nested failure is handled by caller", func(t *testing.T) {
+		require.NoError(t, env.Call(ctx, callee, "callFail", nil, third.Bytes()))
+		require.Len(t, env.events, 2)
+		require.Equal(t, callee, env.events[1].Contract)
+		require.Nil(t, env.GetValue(&ReadContextImpl{Contract: third}, []byte("sender")))
+	})
+
+	t.Run("read", func(t *testing.T) {
+		data, err := env.Read(ctx, callee, "sender")
+		require.NoError(t, err)
+		require.Equal(t, caller.Bytes(), data)
+	})
+
+	t.Run("shared gas", func(t *testing.T) {
+		gas.Reset(300)
+		require.Panics(t, func() {
+			env.Call(ctx, callee, "recurse", nil)
+		})
+	})
+}
+
+type testBalanceCollector struct {
+	collector.StatsCollector
+	updates []common.Address
+}
+
+func (c *testBalanceCollector) AddContractBalanceUpdate(address common.Address, getCurrentBalance collector.GetBalanceFunc, newBalance *big.Int, appState *appstate.AppState) {
+	c.updates = append(c.updates, address)
+}
+
+func TestEnvImp_Call_statsCollector(t *testing.T) {
+	appState, _ := appstate.NewAppState(db2.NewMemDB(), eventbus.New())
+	caller := common.Address{0x1}
+	callee := common.Address{0x2}
+	receiver := common.Address{0x3}
+	appState.State.DeployContract(caller, testNestedCodeHash, big.NewInt(0))
+	appState.State.DeployContract(callee, testNestedCodeHash, big.NewInt(0))
+	appState.State.SetBalance(caller, big.NewInt(100))
+
+	statsCollector := &testBalanceCollector{StatsCollector: collector.NewStatsCollector()}
+	env := NewEnvImp(appState, &types.Header{ProposedHeader: &types.ProposedHeader{Height: 2}}, &GasCounter{gasLimit: -1}, statsCollector)
+	env.SetContractFactory(func(ctx CallContext) NestedContract {
+		return &testNestedContract{ctx: ctx, env: env}
+	})
+	ctx := &ReadContextImpl{Contract: caller, Hash: testNestedCodeHash}
+
+	require.Error(t, env.Call(ctx, callee, "fail", big.NewInt(10)))
+	require.Empty(t, statsCollector.updates)
+
+	require.NoError(t, env.Call(ctx, callee, "send", big.NewInt(30), receiver.Bytes()))
+	require.Equal(t, []common.Address{caller, callee, callee, receiver}, statsCollector.updates)
+	require.Empty(t, env.collectorCalls)
+}
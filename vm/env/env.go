@@ -38,12 +38,14 @@ type Env interface {
 	Iterate(ctx CallContext, minKey []byte, maxKey []byte, f func(key []byte, value []byte) bool)
 	BurnAll(ctx CallContext)
 	ReadContractData(contractAddr common.Address, key []byte) []byte
-	Event(name string, args ...[]byte)
+	Event(ctx CallContext, name string, args ...[]byte)
 	Epoch() uint16
 	ContractStake(common.Address) *big.Int
 	MoveToStake(ctx CallContext, amount *big.Int) error
 	Delegatee(addr common.Address) *common.Address
 	IsDiscriminated(addr common.Address) bool
+	Call(ctx CallContext, contract common.Address, method string, amount *big.Int, args ...[]byte) error
+	Read(ctx CallContext, contract common.Address, method string, args ...[]byte) ([]byte, error)
 }
 
 type contractValue struct {
@@ -63,6 +65,12 @@ type EnvImp struct {
 	droppedContracts      map[common.Address]struct{}
 	events                []*types.TxEvent
 	contractStakeCache    map[common.Address]*big.Int
+	trace                 *Trace
+	contractFactory       ContractFactory
+	callDepth             int
+	// collectorCalls are stats collector calls made by called contracts,
+	// they are passed to the collector when the outermost call succeeds
+	collectorCalls []func()
 }
 
 func NewEnvImp(s *appstate.AppState, block *types.Header, gasCounter *GasCounter, statsCollector collector.StatsCollector) *EnvImp {
@@ -77,6 +85,24 @@ func NewEnvImp(s *appstate.AppState, block *types.Header, gasCounter *GasCounter
 	}
 }
 
+// SetTrace enables recording of env operations to the trace
+func (e *EnvImp) SetTrace(trace *Trace) {
+	e.trace = trace
+}
+
+// SetContractFactory enables calls of contracts created by the factory from other contracts
+func (e *EnvImp) SetContractFactory(factory ContractFactory) {
+	e.contractFactory = factory
+}
+
+func (e *EnvImp) traceOp(op *TraceOp, usedGas int) {
+	if e.trace == nil {
+		return
+	}
+	op.Gas = e.gasCounter.UsedGas - usedGas
+	e.trace.add(op)
+}
+
 func (e *EnvImp) Epoch() uint16 {
 	e.gasCounter.AddGas(10)
 	return e.state.State.Epoch()
@@ -100,10 +126,28 @@ func (e *EnvImp) subBalance(address common.Address, amount *big.Int) {
 }
 
 func (e *EnvImp) setBalance(address common.Address, amount *big.Int) {
-	collector.AddContractBalanceUpdate(e.statsCollector, address, e.getBalance, amount, e.state)
+	prevBalance := e.getBalance(address)
+	e.collect(func() {
+		collector.AddContractBalanceUpdate(e.statsCollector, address, func(common.Address) *big.Int {
+			return prevBalance
+		}, amount, e.state)
+	})
 	e.balancesCache[address] = amount
 }
 
+// collect passes the call to the stats collector, calls made inside a contract call are postponed
+// until the outermost call succeeds, so changes of reverted calls aren't collected
+func (e *EnvImp) collect(f func()) {
+	if e.statsCollector == nil {
+		return
+	}
+	if e.callDepth == 0 {
+		f()
+		return
+	}
+	e.collectorCalls = append(e.collectorCalls, f)
+}
+
 func (e *EnvImp) Send(ctx CallContext, dest common.Address, amount *big.Int) error {
 	balance := e.getBalance(ctx.ContractAddr())
 	if balance.Cmp(amount) < 0 {
@@ -112,10 +156,12 @@ func (e *EnvImp) Send(ctx CallContext, dest common.Address, amount *big.Int) err
 	if amount.Sign() < 0 {
 		return errors.New("value must be non-negative")
 	}
+	usedGas := e.gasCounter.UsedGas
 	e.subBalance(ctx.ContractAddr(), amount)
 	e.addBalance(dest, amount)
 
 	e.gasCounter.AddGas(30)
+	e.traceOp(&TraceOp{Op: TraceSend, Contract: ctx.ContractAddr(), Dest: &dest, Amount: amount}, usedGas)
 	return nil
 }
 
@@ -144,6 +190,7 @@ func (e *EnvImp) SetValue(ctx CallContext, key []byte, value []byte) {
 	if len(key) > common.MaxContractStoreKeyLength {
 		panic("key is too big")
 	}
+	usedGas := e.gasCounter.UsedGas
 	addr := ctx.ContractAddr()
 	var cache map[string]*contractValue
 	var ok bool
@@ -156,10 +203,14 @@ func (e *EnvImp) SetValue(ctx CallContext, key []byte, value []byte) {
 		removed: false,
 	}
 	e.gasCounter.AddWrittenBytesAsGas(10 * (len(key) + len(value)))
+	e.traceOp(&TraceOp{Op: TraceSetValue, Contract: addr, Key: key, Value: value}, usedGas)
 }
 
 func (e *EnvImp) GetValue(ctx CallContext, key []byte) []byte {
-	return e.ReadContractData(ctx.ContractAddr(), key)
+	usedGas := e.gasCounter.UsedGas
+	value := e.readContractData(ctx.ContractAddr(), key)
+	e.traceOp(&TraceOp{Op: TraceGetValue, Contract: ctx.ContractAddr(), Key: key, Value: value}, usedGas)
+	return value
 }
 
 func (e *EnvImp) RemoveValue(ctx CallContext, key []byte) {
@@ -170,8 +221,10 @@ func (e *EnvImp) RemoveValue(ctx CallContext, key []byte) {
 		cache = map[string]*contractValue{}
 		e.contractStoreCache[addr] = cache
 	}
+	usedGas := e.gasCounter.UsedGas
 	cache[string(key)] = &contractValue{removed: true}
 	e.gasCounter.AddGas(5)
+	e.traceOp(&TraceOp{Op: TraceRemoveValue, Contract: addr, Key: key}, usedGas)
 }
 
 func (e *EnvImp) MinFeePerGas() *big.Int {
@@ -243,13 +296,27 @@ func (e *EnvImp) Iterate(ctx CallContext, minKey []byte, maxKey []byte, f func(k
 }
 
 func (e *EnvImp) BurnAll(ctx CallContext) {
+	usedGas := e.gasCounter.UsedGas
 	e.gasCounter.AddReadBytesAsGas(10)
 	address := ctx.ContractAddr()
-	collector.AddContractBurntCoins(e.statsCollector, address, e.getBalance)
+	burnt := e.getBalance(address)
+	e.collect(func() {
+		collector.AddContractBurntCoins(e.statsCollector, address, func(common.Address) *big.Int {
+			return burnt
+		})
+	})
 	e.setBalance(address, common.Big0)
+	e.traceOp(&TraceOp{Op: TraceBurnAll, Contract: address, Amount: burnt}, usedGas)
 }
 
 func (e *EnvImp) ReadContractData(contractAddr common.Address, key []byte) []byte {
+	usedGas := e.gasCounter.UsedGas
+	value := e.readContractData(contractAddr, key)
+	e.traceOp(&TraceOp{Op: TraceReadContractData, Contract: contractAddr, Key: key, Value: value}, usedGas)
+	return value
+}
+
+func (e *EnvImp) readContractData(contractAddr common.Address, key []byte) []byte {
 	if cache, ok := e.contractStoreCache[contractAddr]; ok {
 		if value, ok := cache[string(key)]; ok {
 			if value.removed {
@@ -316,7 +383,7 @@ func (e *EnvImp) Commit() []*types.TxEvent {
 	return e.events
 }
 
-func (e *EnvImp) Event(name string, args ...[]byte) {
+func (e *EnvImp) Event(ctx CallContext, name string, args ...[]byte) {
 	if !eventRegexp.MatchString(name) {
 		panic("event name should contain only ASCII characters. Length should be 1-32")
 	}
@@ -324,10 +391,12 @@ func (e *EnvImp) Event(name string, args ...[]byte) {
 	for _, a := range args {
 		size += len(a)
 	}
+	usedGas := e.gasCounter.UsedGas
 	e.gasCounter.AddGas(100 + 10*size)
 	e.events = append(e.events, &types.TxEvent{
-		EventName: name, Data: args,
+		Contract: ctx.ContractAddr(), EventName: name, Data: args,
 	})
+	e.traceOp(&TraceOp{Op: TraceEvent, Contract: ctx.ContractAddr(), Event: name, Args: args}, usedGas)
 }
 
 func (e *EnvImp) contractStake(contract common.Address) *big.Int {
@@ -354,6 +423,7 @@ func (e *EnvImp) MoveToStake(ctx CallContext, amount *big.Int) error {
 		return errors.New("value must be non-negative")
 	}
 	e.subBalance(ctx.ContractAddr(), amount)
+	e.traceOp(&TraceOp{Op: TraceMoveToStake, Contract: ctx.ContractAddr(), Amount: amount}, e.gasCounter.UsedGas)
 
 	if v, ok := e.deployedContractCache[ctx.ContractAddr()]; ok {
 		v.Stake = big.NewInt(0).Add(v.Stake, amount)
@@ -372,6 +442,8 @@ func (e *EnvImp) Reset() {
 	e.droppedContracts = map[common.Address]struct{}{}
 	e.contractStakeCache = map[common.Address]*big.Int{}
 	e.events = []*types.TxEvent{}
+	e.callDepth = 0
+	e.collectorCalls = nil
 }
 
 type CallContext interface {
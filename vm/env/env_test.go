@@ -108,8 +108,8 @@ func TestEnvImp_basicMethods(t *testing.T) {
 		return false
 	})
 
-	env.Event("test1", []byte{0x1}, []byte{0x2})
-	env.Event("test2", []byte{0x2})
+	env.Event(ctx, "test1", []byte{0x1}, []byte{0x2})
+	env.Event(ctx, "test2", []byte{0x2})
 
 	events := env.Commit()
 	env.Reset()
@@ -154,3 +154,52 @@ func TestEnvImp_basicMethods(t *testing.T) {
 	})
 	require.Equal(t, 1, cnt)
 }
+
+func TestEnvImp_trace(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	key, _ := crypto.GenerateKeyFromSeed(rnd)
+	attachment := attachments.CreateDeployContractAttachment(common.Hash{0x1})
+	payload, _ := attachment.ToBytes()
+	tx := &types.Transaction{
+		AccountNonce: 1,
+		Type:         types.DeployContractTx,
+		Amount:       common.DnaBase,
+		Payload:      payload,
+	}
+	tx, _ = types.SignTx(tx, key)
+	ctx := NewDeployContextImpl(tx, nil, attachment.CodeHash)
+
+	appState, _ := appstate.NewAppState(db2.NewMemDB(), eventbus.New())
+	appState.State.AddBalance(ctx.ContractAddr(), big.NewInt(100))
+	other := common.Address{0x2}
+	appState.State.SetContractValue(other, []byte{0x1}, []byte{0x3})
+
+	gas := &GasCounter{gasLimit: -1}
+	env := NewEnvImp(appState, &types.Header{ProposedHeader: &types.ProposedHeader{Height: 2}}, gas, nil)
+	env.Deploy(ctx)
+	gas.Reset(-1)
+	trace := new(Trace)
+	env.SetTrace(trace)
+
+	env.SetValue(ctx, []byte{0x1}, []byte{0x2})
+	require.Equal(t, []byte{0x2}, env.GetValue(ctx, []byte{0x1}))
+	env.RemoveValue(ctx, []byte{0x1})
+	require.Equal(t, []byte{0x3}, env.ReadContractData(other, []byte{0x1}))
+	require.NoError(t, env.Send(ctx, other, big.NewInt(10)))
+	require.NoError(t, env.MoveToStake(ctx, big.NewInt(20)))
+	env.Event(ctx, "test", []byte{0x1})
+	env.BurnAll(ctx)
+
+	var ops []string
+	totalGas := 0
+	for _, op := range trace.Ops {
+		ops = append(ops, op.Op)
+		totalGas += op.Gas
+	}
+	require.Equal(t, []string{TraceSetValue, TraceGetValue, TraceRemoveValue, TraceReadContractData, TraceSend,
+		TraceMoveToStake, TraceEvent, TraceBurnAll}, ops)
+	require.Equal(t, gas.UsedGas, totalGas)
+	require.Equal(t, other, trace.Ops[3].Contract)
+	require.Equal(t, big.NewInt(10), trace.Ops[4].Amount)
+	require.Equal(t, big.NewInt(70), trace.Ops[7].Amount)
+}
@@ -24,3 +24,7 @@ func (g *GasCounter) Reset(gasLimit int) {
 	g.UsedGas = 0
 	g.gasLimit = gasLimit
 }
+
+func (g *GasCounter) limitExceeded() bool {
+	return g.gasLimit >= 0 && g.gasLimit < g.UsedGas
+}
@@ -0,0 +1,55 @@
+package env
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"math/big"
+)
+
+const (
+	TraceSetValue         = "setValue"
+	TraceGetValue         = "getValue"
+	TraceRemoveValue      = "removeValue"
+	TraceReadContractData = "readContractData"
+	TraceSend             = "send"
+	TraceMoveToStake      = "moveToStake"
+	TraceBurnAll          = "burnAll"
+	TraceEvent            = "event"
+	TraceCall             = "call"
+	TraceRead             = "read"
+)
+
+// TraceOp is an env operation performed by a contract, Gas is the gas charged for the operation
+type TraceOp struct {
+	Op       string
+	Contract common.Address
+	Key      []byte
+	Value    []byte
+	Dest     *common.Address
+	Amount   *big.Int
+	Event    string
+	Method   string
+	Args     [][]byte
+	Gas      int
+}
+
+// Trace records env operations of a contract execution in order they are performed.
+// Panic is set if the execution is interrupted by a panic, e.g. when gas limit is exceeded.
+type Trace struct {
+	Ops   []*TraceOp
+	Panic string
+}
+
+func (t *Trace) add(op *TraceOp) {
+	if t == nil {
+		return
+	}
+	t.Ops = append(t.Ops, op)
+}
+
+func (t *Trace) SetPanic(r interface{}) {
+	if t == nil {
+		return
+	}
+	t.Panic = fmt.Sprint(r)
+}
@@ -0,0 +1,164 @@
+package vm
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/idena-network/idena-go/vm/embedded"
+	env2 "github.com/idena-network/idena-go/vm/env"
+)
+
+// ImplementationVersionKey is the contract storage key of the implementation version recorded at deploy since consensus v9
+const ImplementationVersionKey = "_impl"
+
+type ContractConstructor func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract
+
+// ContractImplementation is an implementation of an embedded contract deployed since the consensus version.
+// Versions of implementations of the same contract increase with consensus versions.
+type ContractImplementation struct {
+	CodeHash  embedded.EmbeddedContractType
+	Version   byte
+	Consensus config.ConsensusVerson
+	Name      string
+	New       ContractConstructor
+}
+
+var implementations = map[embedded.EmbeddedContractType][]*ContractImplementation{}
+
+func registerImplementation(impl *ContractImplementation) {
+	registered := implementations[impl.CodeHash]
+	if len(registered) > 0 {
+		last := registered[len(registered)-1]
+		if last.Version >= impl.Version || last.Consensus > impl.Consensus {
+			panic(fmt.Sprintf("implementation %v should follow %v", impl.Name, last.Name))
+		}
+	}
+	implementations[impl.CodeHash] = append(registered, impl)
+}
+
+func init() {
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.TimeLockContract, Version: 1, Consensus: config.ConsensusV6, Name: "TimeLock",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewTimeLock(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.OracleVotingContract, Version: 1, Consensus: config.ConsensusV6, Name: "OracleVoting3",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewOracleVotingContract3(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.OracleVotingContract, Version: 2, Consensus: config.ConsensusV7, Name: "OracleVoting4",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewOracleVotingContract4(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.OracleVotingContract, Version: 3, Consensus: config.ConsensusV8, Name: "OracleVoting5",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewOracleVotingContract5(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.OracleLockContract, Version: 1, Consensus: config.ConsensusV6, Name: "OracleLock2",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewOracleLock2(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.RefundableOracleLockContract, Version: 1, Consensus: config.ConsensusV6, Name: "RefundableOracleLock2",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewRefundableOracleLock2(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.MultisigContract, Version: 1, Consensus: config.ConsensusV6, Name: "Multisig",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewMultisig(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.FungibleTokenContract, Version: 1, Consensus: config.ConsensusV9, Name: "FungibleToken",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewFungibleToken(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.VestingContract, Version: 1, Consensus: config.ConsensusV9, Name: "Vesting",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewVesting(ctx, e, statsCollector)
+		},
+	})
+	registerImplementation(&ContractImplementation{
+		CodeHash: embedded.Multisig2Contract, Version: 1, Consensus: config.ConsensusV9, Name: "Multisig2",
+		New: func(ctx env2.CallContext, e env2.Env, statsCollector collector.StatsCollector) embedded.Contract {
+			return embedded.NewMultisig2(ctx, e, statsCollector)
+		},
+	})
+}
+
+// consensusVersion returns the version of enabled upgrades, tests may enable upgrades without setting the version
+func consensusVersion(cfg *config.ConsensusConf) config.ConsensusVerson {
+	switch {
+	case cfg.EnableUpgrade9:
+		return config.ConsensusV9
+	case cfg.EnableUpgrade8:
+		return config.ConsensusV8
+	case cfg.EnableUpgrade7:
+		return config.ConsensusV7
+	default:
+		return config.ConsensusV6
+	}
+}
+
+// LatestImplementation returns the latest implementation of the contract available with the consensus version
+func LatestImplementation(codeHash embedded.EmbeddedContractType, consensus config.ConsensusVerson) *ContractImplementation {
+	registered := implementations[codeHash]
+	for i := len(registered) - 1; i >= 0; i-- {
+		if registered[i].Consensus <= consensus {
+			return registered[i]
+		}
+	}
+	return nil
+}
+
+func ImplementationByVersion(codeHash embedded.EmbeddedContractType, version byte) *ContractImplementation {
+	for _, impl := range implementations[codeHash] {
+		if impl.Version == version {
+			return impl
+		}
+	}
+	return nil
+}
+
+// resolveImplementation returns the implementation recorded at deploy if any,
+// contracts deployed before versioning use the latest implementation available with the current consensus
+func resolveImplementation(appState *appstate.AppState, consensus *config.ConsensusConf, contract common.Address, codeHash common.Hash) (impl *ContractImplementation, recorded bool) {
+	if version := appState.State.GetContractValue(contract, []byte(ImplementationVersionKey)); len(version) == 1 {
+		return ImplementationByVersion(codeHash, version[0]), true
+	}
+	return LatestImplementation(codeHash, consensusVersion(consensus)), false
+}
+
+// NewContract creates the implementation of the contract the vm uses for the context, it returns nil for unknown contracts
+func NewContract(appState *appstate.AppState, consensus *config.ConsensusConf, ctx env2.CallContext, e env2.Env) embedded.Contract {
+	impl, _ := resolveImplementation(appState, consensus, ctx.ContractAddr(), ctx.CodeHash())
+	if impl == nil {
+		return nil
+	}
+	return impl.New(ctx, e, nil)
+}
+
+// DeployedImplementation returns the implementation used by the deployed contract, recorded is false for contracts
+// deployed before versioning which follow consensus upgrades
+func DeployedImplementation(appState *appstate.AppState, consensus *config.ConsensusConf, contract common.Address) (impl *ContractImplementation, recorded bool) {
+	codeHash := appState.State.GetCodeHash(contract)
+	if codeHash == nil {
+		return nil, false
+	}
+	return resolveImplementation(appState, consensus, contract, *codeHash)
+}
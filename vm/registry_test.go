@@ -0,0 +1,85 @@
+package vm
+
+import (
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func TestLatestImplementation(t *testing.T) {
+	require.Equal(t, "OracleVoting3", LatestImplementation(embedded.OracleVotingContract, config.ConsensusV6).Name)
+	require.Equal(t, "OracleVoting4", LatestImplementation(embedded.OracleVotingContract, config.ConsensusV7).Name)
+	require.Equal(t, "OracleVoting5", LatestImplementation(embedded.OracleVotingContract, config.ConsensusV8).Name)
+	require.Equal(t, "OracleVoting5", LatestImplementation(embedded.OracleVotingContract, config.ConsensusV9).Name)
+	require.Nil(t, LatestImplementation(embedded.FungibleTokenContract, config.ConsensusV8))
+	require.Equal(t, "FungibleToken", LatestImplementation(embedded.FungibleTokenContract, config.ConsensusV9).Name)
+	require.Nil(t, LatestImplementation(common.Hash{0xff}, config.ConsensusV9))
+
+	require.Equal(t, "OracleVoting4", ImplementationByVersion(embedded.OracleVotingContract, 2).Name)
+	require.Nil(t, ImplementationByVersion(embedded.OracleVotingContract, 4))
+
+	for codeHash := range embedded.AvailableContracts {
+		require.NotNil(t, LatestImplementation(codeHash, config.ConsensusV9))
+	}
+}
+
+func TestDeployedImplementation(t *testing.T) {
+	appState, _ := appstate.NewAppState(dbm.NewMemDB(), eventbus.New())
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	appState.State.SetBalance(sender, big.NewInt(0).Mul(common.DnaBase, big.NewInt(10)))
+	appState.Commit(nil, true)
+
+	v8Cfg := config.ConsensusVersions[config.ConsensusV8]
+	v9Cfg := *v8Cfg
+	v9Cfg.EnableUpgrade9 = true
+
+	deploy := func(consensusCfg config.ConsensusConf, nonce uint32) common.Address {
+		payload, _ := attachments.CreateDeployContractAttachment(embedded.TimeLockContract, common.ToBytes(uint64(10))).ToBytes()
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			Type:         types.DeployContractTx,
+			Amount:       common.DnaBase,
+			Payload:      payload,
+		}, key)
+		header := &types.Header{ProposedHeader: &types.ProposedHeader{Height: 2}}
+		receipt := NewVmImpl(appState, header, nil, &config.Config{Consensus: &consensusCfg}).Run(tx, nil, -1)
+		require.True(t, receipt.Success)
+		return receipt.ContractAddress
+	}
+
+	legacy := deploy(*v8Cfg, 1)
+	impl, recorded := DeployedImplementation(appState, &v9Cfg, legacy)
+	require.False(t, recorded)
+	require.Equal(t, "TimeLock", impl.Name)
+	require.Nil(t, appState.State.GetContractValue(legacy, []byte(ImplementationVersionKey)))
+
+	versioned := deploy(v9Cfg, 2)
+	impl, recorded = DeployedImplementation(appState, &v9Cfg, versioned)
+	require.True(t, recorded)
+	require.Equal(t, byte(1), impl.Version)
+	require.Equal(t, []byte{1}, appState.State.GetContractValue(versioned, []byte(ImplementationVersionKey)))
+
+	oracleVoting := common.Address{0x1}
+	appState.State.DeployContract(oracleVoting, embedded.OracleVotingContract, big.NewInt(0))
+	impl, recorded = DeployedImplementation(appState, v8Cfg, oracleVoting)
+	require.False(t, recorded)
+	require.Equal(t, "OracleVoting5", impl.Name)
+
+	appState.State.SetContractValue(oracleVoting, []byte(ImplementationVersionKey), []byte{2})
+	impl, recorded = DeployedImplementation(appState, &v9Cfg, oracleVoting)
+	require.True(t, recorded)
+	require.Equal(t, "OracleVoting4", impl.Name)
+
+	impl, _ = DeployedImplementation(appState, &v9Cfg, common.Address{0x2})
+	require.Nil(t, impl)
+}
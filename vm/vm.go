@@ -29,37 +29,45 @@ type VmImpl struct {
 	gasCounter     *env2.GasCounter
 	statsCollector collector.StatsCollector
 	cfg            *config.Config
+	trace          *env2.Trace
 }
 
 type VmCreator = func(appState *appstate.AppState, block *types.Header, statsCollector collector.StatsCollector, cfg *config.Config) VM
 
 func NewVmImpl(appState *appstate.AppState, block *types.Header, statsCollector collector.StatsCollector, cfg *config.Config) VM {
 	gasCounter := new(env2.GasCounter)
-	return &VmImpl{env: env2.NewEnvImp(appState, block, gasCounter, statsCollector), appState: appState, gasCounter: gasCounter,
+	vm := &VmImpl{env: env2.NewEnvImp(appState, block, gasCounter, statsCollector), appState: appState, gasCounter: gasCounter,
 		statsCollector: statsCollector, cfg: cfg}
+	vm.env.SetContractFactory(vm.nestedContract)
+	return vm
+}
+
+// NewTracingVmImpl creates vm recording env operations and the panic point of executed contracts to the trace
+func NewTracingVmImpl(appState *appstate.AppState, block *types.Header, cfg *config.Config, trace *env2.Trace) VM {
+	vm := NewVmImpl(appState, block, nil, cfg).(*VmImpl)
+	vm.env.SetTrace(trace)
+	vm.trace = trace
+	return vm
+}
+
+func (vm *VmImpl) implementation(ctx env2.CallContext) *ContractImplementation {
+	impl, _ := resolveImplementation(vm.appState, vm.cfg.Consensus, ctx.ContractAddr(), ctx.CodeHash())
+	return impl
 }
 
 func (vm *VmImpl) createContract(ctx env2.CallContext) embedded.Contract {
-	switch ctx.CodeHash() {
-	case embedded.TimeLockContract:
-		return embedded.NewTimeLock(ctx, vm.env, vm.statsCollector)
-	case embedded.OracleVotingContract:
-		if vm.cfg.Consensus.EnableUpgrade8 {
-			return embedded.NewOracleVotingContract5(ctx, vm.env, vm.statsCollector)
-		}
-		if vm.cfg.Consensus.EnableUpgrade7 {
-			return embedded.NewOracleVotingContract4(ctx, vm.env, vm.statsCollector)
-		}
-		return embedded.NewOracleVotingContract3(ctx, vm.env, vm.statsCollector)
-	case embedded.OracleLockContract:
-		return embedded.NewOracleLock2(ctx, vm.env, vm.statsCollector)
-	case embedded.RefundableOracleLockContract:
-		return embedded.NewRefundableOracleLock2(ctx, vm.env, vm.statsCollector)
-	case embedded.MultisigContract:
-		return embedded.NewMultisig(ctx, vm.env, vm.statsCollector)
-	default:
+	impl := vm.implementation(ctx)
+	if impl == nil {
 		return nil
 	}
+	return impl.New(ctx, vm.env, vm.statsCollector)
+}
+
+func (vm *VmImpl) nestedContract(ctx env2.CallContext) env2.NestedContract {
+	if contract := vm.createContract(ctx); contract != nil {
+		return contract
+	}
+	return nil
 }
 
 func (vm *VmImpl) deploy(tx *types.Transaction, from *common.Address) (addr common.Address, err error) {
@@ -69,16 +77,21 @@ func (vm *VmImpl) deploy(tx *types.Transaction, from *common.Address) (addr comm
 	if attach == nil {
 		return addr, errors.New("can't parse attachment")
 	}
-	contract := vm.createContract(ctx)
-	if contract == nil {
+	impl := vm.implementation(ctx)
+	if impl == nil {
 		return addr, errors.New("unknown contract")
 	}
+	contract := impl.New(ctx, vm.env, vm.statsCollector)
 	defer func() {
 		if r := recover(); r != nil {
+			vm.trace.SetPanic(r)
 			err = errors.New(fmt.Sprint(r))
 		}
 	}()
 	err = contract.Deploy(attach.Args...)
+	if err == nil && vm.cfg.Consensus.EnableUpgrade9 {
+		vm.env.SetValue(ctx, []byte(ImplementationVersionKey), []byte{impl.Version})
+	}
 	vm.env.Deploy(ctx)
 	return addr, err
 }
@@ -102,6 +115,7 @@ func (vm *VmImpl) call(tx *types.Transaction, from *common.Address) (addr common
 
 	defer func() {
 		if r := recover(); r != nil {
+			vm.trace.SetPanic(r)
 			err = errors.New(fmt.Sprint(r))
 		}
 	}()
@@ -122,6 +136,7 @@ func (vm *VmImpl) terminate(tx *types.Transaction, from *common.Address) (addr c
 	}
 	defer func() {
 		if r := recover(); r != nil {
+			vm.trace.SetPanic(r)
 			err = errors.New(fmt.Sprint(r))
 		}
 	}()
@@ -0,0 +1,71 @@
+package vmtest
+
+import (
+	"crypto/ecdsa"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
+	"math/rand"
+)
+
+// IdentityGroup configures identities of the test network, Count identities of the group are created with the same settings
+type IdentityGroup struct {
+	Count               int
+	State               state.IdentityState
+	Delegatee           *common.Address
+	PendingUndelegation bool
+}
+
+// newNetwork creates the state with identities of the groups in order, a single newbie identity is created if there are no groups.
+// Keys of identities are generated from the seed, the balance of every identity is set if it isn't nil.
+func newNetwork(groups []IdentityGroup, balance *big.Int, seed int64) (*appstate.AppState, []*ecdsa.PrivateKey, error) {
+	appState, err := appstate.NewAppState(dbm.NewMemDB(), eventbus.New())
+	if err != nil {
+		return nil, nil, err
+	}
+	appState.State.SetFeePerGas(big.NewInt(1))
+
+	if len(groups) == 0 {
+		groups = []IdentityGroup{{Count: 1, State: state.Newbie}}
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	var identities []*ecdsa.PrivateKey
+	for _, group := range groups {
+		for i := 0; i < group.Count; i++ {
+			key, err := crypto.GenerateKeyFromSeed(rnd)
+			if err != nil {
+				return nil, nil, err
+			}
+			identities = append(identities, key)
+			addr := crypto.PubkeyToAddress(key.PublicKey)
+			appState.State.SetPubKey(addr, crypto.FromECDSAPub(&key.PublicKey))
+			if balance != nil {
+				appState.State.SetBalance(addr, balance)
+			}
+			appState.State.SetState(addr, group.State)
+			if group.State.NewbieOrBetter() {
+				appState.IdentityState.SetValidated(addr, true)
+			}
+			if group.PendingUndelegation {
+				appState.State.SetPendingUndelegation(addr)
+			}
+			if group.Delegatee != nil {
+				appState.State.SetDelegatee(addr, *group.Delegatee)
+				if !group.PendingUndelegation {
+					appState.IdentityState.SetDelegatee(addr, *group.Delegatee)
+				}
+			}
+		}
+	}
+	if err := appState.Commit(nil, true); err != nil {
+		return nil, nil, err
+	}
+	if err := appState.Initialize(1); err != nil {
+		return nil, nil, err
+	}
+	return appState, identities, nil
+}
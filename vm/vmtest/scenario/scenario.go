@@ -0,0 +1,454 @@
+// Package scenario runs declarative json scenarios of embedded contracts with vmtest.Tester,
+// contracts are created with implementations of the vm.
+package scenario
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/idena-network/idena-go/vm/vmtest"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"io/ioutil"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const identityRefPrefix = "identity:"
+
+// Scenario is a sequence of steps executed by a tester built for the scenario identities.
+//
+// Addresses are referenced in steps by hex, by "identity:N" where N is the identity index
+// or by the label of a contract deployed by one of the previous steps.
+type Scenario struct {
+	Name       string       `json:"name"`
+	Identities []Identities `json:"identities"`
+	// Balance is the initial balance of every identity in DNA
+	Balance string  `json:"balance"`
+	Steps   []*Step `json:"steps"`
+}
+
+type Identities struct {
+	Count int    `json:"count"`
+	State string `json:"state"`
+}
+
+// Step changes the block height or timestamp, executes at most one transaction or read and checks expectations.
+// The transaction is expected to succeed unless ExpectError is set, events are compared with all events of the transaction.
+type Step struct {
+	Name      string         `json:"name"`
+	Height    *uint64        `json:"height,omitempty"`
+	Timestamp *int64         `json:"timestamp,omitempty"`
+	Deploy    *DeployStep    `json:"deploy,omitempty"`
+	Call      *CallStep      `json:"call,omitempty"`
+	Terminate *TerminateStep `json:"terminate,omitempty"`
+	Read      *ReadStep      `json:"read,omitempty"`
+	// ExpectError is a substring of the expected error
+	ExpectError   string              `json:"expectError,omitempty"`
+	ExpectEvents  []*EventExpectation `json:"expectEvents,omitempty"`
+	ExpectBalance map[string]string   `json:"expectBalance,omitempty"`
+	ExpectStake   map[string]string   `json:"expectStake,omitempty"`
+}
+
+type DeployStep struct {
+	// Contract is the contract name or its code hash
+	Contract string `json:"contract"`
+	// Label names the deployed contract for next steps
+	Label  string `json:"label"`
+	As     int    `json:"as"`
+	Amount string `json:"amount,omitempty"`
+	Args   []Arg  `json:"args,omitempty"`
+}
+
+type CallStep struct {
+	Contract string `json:"contract"`
+	Method   string `json:"method"`
+	As       int    `json:"as"`
+	Amount   string `json:"amount,omitempty"`
+	Args     []Arg  `json:"args,omitempty"`
+}
+
+type TerminateStep struct {
+	Contract string `json:"contract"`
+	As       int    `json:"as"`
+	Args     []Arg  `json:"args,omitempty"`
+}
+
+type ReadStep struct {
+	Contract string `json:"contract"`
+	Method   string `json:"method"`
+	Args     []Arg  `json:"args,omitempty"`
+	Result   *Arg   `json:"result,omitempty"`
+}
+
+type EventExpectation struct {
+	Event string `json:"event"`
+	// Args are compared if set
+	Args []Arg `json:"args,omitempty"`
+}
+
+// Arg is a value in one of embedded.ArgDescriptor formats, address values may be references
+type Arg struct {
+	Format string `json:"format"`
+	Value  string `json:"value"`
+}
+
+func Load(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	scenario := new(Scenario)
+	if err := json.Unmarshal(data, scenario); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse scenario %v", path)
+	}
+	if scenario.Name == "" {
+		scenario.Name = path
+	}
+	return scenario, nil
+}
+
+type runner struct {
+	tester    *vmtest.Tester
+	contracts map[string]common.Address
+}
+
+// result is the outcome of a transaction step
+type result struct {
+	err    error
+	events []*types.TxEvent
+}
+
+// consensus returns the config of the latest consensus with upgrades which aren't scheduled yet, so all contracts are available
+func consensus() *config.ConsensusConf {
+	conf := *config.ConsensusVersions[config.ConsensusV8]
+	conf.EnableUpgrade9 = true
+	return &conf
+}
+
+// Run executes steps of the scenario on a new tester, the returned error describes the first failed step
+func (s *Scenario) Run() error {
+	conf := consensus()
+	var tester *vmtest.Tester
+	builder := vmtest.NewBuilder(func(ctx env.CallContext, e env.Env) vmtest.Contract {
+		return vm.NewContract(tester.AppState(), conf, ctx, e)
+	})
+	for _, group := range s.Identities {
+		identityState := state.Newbie
+		if group.State != "" {
+			var err error
+			if identityState, err = state.ParseIdentityState(group.State); err != nil {
+				return err
+			}
+		}
+		builder.AddIdentities(vmtest.IdentityGroup{Count: group.Count, State: identityState})
+	}
+	if s.Balance != "" {
+		balance, err := parseDna(s.Balance)
+		if err != nil {
+			return err
+		}
+		builder.SetBalance(balance)
+	}
+	var err error
+	if tester, err = builder.Build(); err != nil {
+		return err
+	}
+	r := &runner{tester: tester, contracts: map[string]common.Address{}}
+	for i, step := range s.Steps {
+		if err := r.run(step); err != nil {
+			if step.Name != "" {
+				return errors.Wrapf(err, "step %v (%v)", i, step.Name)
+			}
+			return errors.Wrapf(err, "step %v", i)
+		}
+	}
+	return nil
+}
+
+func (r *runner) run(step *Step) error {
+	if step.Height != nil {
+		r.tester.SetHeight(*step.Height)
+	}
+	if step.Timestamp != nil {
+		r.tester.SetTimestamp(*step.Timestamp)
+	}
+	res, err := r.execute(step)
+	if err != nil {
+		return err
+	}
+	if res != nil {
+		if err := r.checkResult(step, res); err != nil {
+			return err
+		}
+	} else if step.ExpectError != "" || len(step.ExpectEvents) > 0 {
+		return errors.New("errors and events can be expected for transactions only")
+	}
+	if err := r.checkAmounts(step.ExpectBalance, r.tester.AppState().State.GetBalance); err != nil {
+		return errors.Wrap(err, "balance")
+	}
+	if err := r.checkAmounts(step.ExpectStake, r.tester.AppState().State.GetContractStake); err != nil {
+		return errors.Wrap(err, "stake")
+	}
+	return nil
+}
+
+func (r *runner) execute(step *Step) (*result, error) {
+	switch {
+	case step.Deploy != nil:
+		codeHash, err := contractCodeHash(step.Deploy.Contract)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseAmount(step.Deploy.Amount)
+		if err != nil {
+			return nil, err
+		}
+		args, err := r.args(step.Deploy.Args)
+		if err != nil {
+			return nil, err
+		}
+		return r.apply(step.Deploy.As, nil, amount, func(key *ecdsa.PrivateKey) error {
+			addr, err := r.tester.Deploy(key, codeHash, amount, args...)
+			if err == nil && step.Deploy.Label != "" {
+				r.contracts[step.Deploy.Label] = addr
+			}
+			return err
+		})
+	case step.Call != nil:
+		contract, err := r.address(step.Call.Contract)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := parseAmount(step.Call.Amount)
+		if err != nil {
+			return nil, err
+		}
+		args, err := r.args(step.Call.Args)
+		if err != nil {
+			return nil, err
+		}
+		return r.apply(step.Call.As, &contract, amount, func(key *ecdsa.PrivateKey) error {
+			return r.tester.Call(key, contract, amount, step.Call.Method, args...)
+		})
+	case step.Terminate != nil:
+		contract, err := r.address(step.Terminate.Contract)
+		if err != nil {
+			return nil, err
+		}
+		args, err := r.args(step.Terminate.Args)
+		if err != nil {
+			return nil, err
+		}
+		return r.apply(step.Terminate.As, &contract, nil, func(key *ecdsa.PrivateKey) error {
+			_, err := r.tester.Terminate(key, contract, args...)
+			return err
+		})
+	case step.Read != nil:
+		return nil, r.read(step.Read)
+	}
+	return nil, nil
+}
+
+// apply executes the transaction of the identity like the blockchain does except for fees which are not charged:
+// the amount is taken from the sender and sent to the destination if any (the deploy amount is the contract stake),
+// changes of the failed transaction are discarded
+func (r *runner) apply(identity int, to *common.Address, amount *big.Int, execute func(key *ecdsa.PrivateKey) error) (*result, error) {
+	key, err := r.tester.Key(identity)
+	if err != nil {
+		return nil, err
+	}
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	if amount.Sign() < 0 {
+		return nil, errors.New("amount must be non-negative")
+	}
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	stateDB := r.tester.AppState().State
+	if stateDB.GetBalance(sender).Cmp(amount) < 0 {
+		return nil, errors.New("insufficient funds")
+	}
+	stateDB.SubBalance(sender, amount)
+	if to != nil {
+		stateDB.AddBalance(*to, amount)
+	}
+	if err := execute(key); err != nil {
+		r.tester.Revert()
+		stateDB.AddBalance(sender, amount)
+		if to != nil {
+			stateDB.SubBalance(*to, amount)
+		}
+		r.tester.Commit()
+		return &result{err: err}, nil
+	}
+	return &result{events: r.tester.Commit()}, nil
+}
+
+func (r *runner) read(step *ReadStep) error {
+	contract, err := r.address(step.Contract)
+	if err != nil {
+		return err
+	}
+	args, err := r.args(step.Args)
+	if err != nil {
+		return err
+	}
+	data, err := r.tester.Read(contract, step.Method, args...)
+	if err != nil {
+		return errors.Wrapf(err, "read %v", step.Method)
+	}
+	if step.Result == nil {
+		return nil
+	}
+	expected, err := r.arg(*step.Result)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected, data) {
+		return errors.Errorf("read %v: expected %v, got %v", step.Method, hexutil.Encode(expected), hexutil.Encode(data))
+	}
+	return nil
+}
+
+func (r *runner) checkResult(step *Step, res *result) error {
+	if step.ExpectError != "" {
+		if res.err == nil {
+			return errors.Errorf("error \"%v\" is expected", step.ExpectError)
+		}
+		if !strings.Contains(res.err.Error(), step.ExpectError) {
+			return errors.Errorf("error \"%v\" is expected, got \"%v\"", step.ExpectError, res.err)
+		}
+		return nil
+	}
+	if res.err != nil {
+		return errors.Errorf("unexpected error: %v", res.err)
+	}
+	if step.ExpectEvents == nil {
+		return nil
+	}
+	if len(step.ExpectEvents) != len(res.events) {
+		return errors.Errorf("%v events are expected, got %v", len(step.ExpectEvents), len(res.events))
+	}
+	for i, expected := range step.ExpectEvents {
+		event := res.events[i]
+		if expected.Event != event.EventName {
+			return errors.Errorf("event %v: expected %v, got %v", i, expected.Event, event.EventName)
+		}
+		if expected.Args == nil {
+			continue
+		}
+		if len(expected.Args) != len(event.Data) {
+			return errors.Errorf("event %v: %v args are expected, got %v", i, len(expected.Args), len(event.Data))
+		}
+		for j, arg := range expected.Args {
+			value, err := r.arg(arg)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(value, event.Data[j]) {
+				return errors.Errorf("event %v arg %v: expected %v, got %v", i, j, hexutil.Encode(value), hexutil.Encode(event.Data[j]))
+			}
+		}
+	}
+	return nil
+}
+
+func (r *runner) checkAmounts(expected map[string]string, actual func(common.Address) *big.Int) error {
+	for ref, value := range expected {
+		addr, err := r.address(ref)
+		if err != nil {
+			return err
+		}
+		amount, err := parseDna(value)
+		if err != nil {
+			return err
+		}
+		if v := actual(addr); v.Cmp(amount) != 0 {
+			return errors.Errorf("%v: expected %v, got %v", ref, value, blockchain.ConvertToFloat(v))
+		}
+	}
+	return nil
+}
+
+func (r *runner) address(ref string) (common.Address, error) {
+	if strings.HasPrefix(ref, identityRefPrefix) {
+		index, err := strconv.Atoi(strings.TrimPrefix(ref, identityRefPrefix))
+		if err != nil {
+			return common.Address{}, errors.Errorf("invalid identity reference %v", ref)
+		}
+		return r.tester.Identity(index)
+	}
+	if addr, ok := r.contracts[ref]; ok {
+		return addr, nil
+	}
+	if common.IsHexAddress(ref) {
+		return common.HexToAddress(ref), nil
+	}
+	return common.Address{}, errors.Errorf("unknown address %v", ref)
+}
+
+func (r *runner) args(args []Arg) ([][]byte, error) {
+	var res [][]byte
+	for i, arg := range args {
+		data, err := r.arg(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "arg %v", i)
+		}
+		res = append(res, data)
+	}
+	return res, nil
+}
+
+func (r *runner) arg(arg Arg) ([]byte, error) {
+	if arg.Format == embedded.AddressFormat {
+		addr, err := r.address(arg.Value)
+		if err != nil {
+			return nil, err
+		}
+		return addr.Bytes(), nil
+	}
+	return embedded.ConvertArg(arg.Format, arg.Value)
+}
+
+func contractCodeHash(contract string) (common.Hash, error) {
+	for codeHash, descriptor := range embedded.Descriptors {
+		if descriptor.Name == contract {
+			return codeHash, nil
+		}
+	}
+	data, err := hexutil.Decode(contract)
+	if err != nil || len(data) != common.HashLength {
+		return common.Hash{}, errors.Errorf("unknown contract %v", contract)
+	}
+	return common.BytesToHash(data), nil
+}
+
+func parseAmount(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return parseDna(value)
+}
+
+func parseDna(value string) (*big.Int, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, errors.Errorf("cannot parse dna: \"%v\"", value)
+	}
+	if v := blockchain.ConvertToInt(d); v != nil {
+		return v, nil
+	}
+	return new(big.Int), nil
+}
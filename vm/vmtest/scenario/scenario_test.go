@@ -0,0 +1,56 @@
+package scenario
+
+import (
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+)
+
+func TestScenarios(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		scenario, err := Load(file)
+		require.NoError(t, err)
+		t.Run(scenario.Name, func(t *testing.T) {
+			require.NoError(t, scenario.Run())
+		})
+	}
+}
+
+func TestScenario_failedExpectation(t *testing.T) {
+	uint64Ptr := func(v uint64) *uint64 {
+		return &v
+	}
+	scenario := &Scenario{
+		Balance: "10",
+		Steps: []*Step{
+			{
+				Height: uint64Ptr(5),
+				Deploy: &DeployStep{Contract: "TimeLock", Label: "lock", Args: []Arg{{Format: embedded.Uint64Format, Value: "100"}}},
+			},
+			{
+				Name: "transfer",
+				Call: &CallStep{Contract: "lock", Method: "transfer", Args: []Arg{
+					{Format: embedded.AddressFormat, Value: "identity:0"},
+					{Format: embedded.DnaFormat, Value: "1"},
+				}},
+			},
+		},
+	}
+	err := scenario.Run()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "step 1 (transfer)")
+	require.Contains(t, err.Error(), "transfer is locked")
+
+	scenario.Steps[1].ExpectError = "locked"
+	scenario.Steps[1].ExpectBalance = map[string]string{"identity:0": "9"}
+	err = scenario.Run()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "balance")
+
+	scenario.Steps[1].ExpectBalance = map[string]string{"identity:0": "10"}
+	require.NoError(t, scenario.Run())
+}
@@ -0,0 +1,298 @@
+// Package vmtest runs embedded contracts of a test network on an in-memory state without a node.
+// It doesn't depend on the vm: contracts are created by the factory of the tester, so tests of the embedded
+// package use it with their own contracts and vmtest/scenario uses it with implementations of the vm.
+package vmtest
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+// Contract is an embedded contract executed by the tester, embedded.Contract implements it
+type Contract interface {
+	Deploy(args ...[]byte) error
+	Call(method string, args ...[]byte) error
+	Read(method string, args ...[]byte) ([]byte, error)
+	Terminate(args ...[]byte) (stakeDest common.Address, keysToSave [][]byte, err error)
+}
+
+// ContractFactory creates the contract for the context, it returns nil for unknown contracts
+type ContractFactory func(ctx env.CallContext, e env.Env) Contract
+
+type Builder struct {
+	factory ContractFactory
+	groups  []IdentityGroup
+	balance *big.Int
+	seed    int64
+}
+
+// NewBuilder creates a builder of a tester with a single newbie identity if no groups are added
+func NewBuilder(factory ContractFactory) *Builder {
+	return &Builder{factory: factory, seed: 1}
+}
+
+func (b *Builder) AddIdentities(groups ...IdentityGroup) *Builder {
+	b.groups = append(b.groups, groups...)
+	return b
+}
+
+// SetBalance sets the initial balance of every identity
+func (b *Builder) SetBalance(balance *big.Int) *Builder {
+	b.balance = balance
+	return b
+}
+
+// SetSeed sets the seed of identity keys generation
+func (b *Builder) SetSeed(seed int64) *Builder {
+	b.seed = seed
+	return b
+}
+
+func (b *Builder) Build() (*Tester, error) {
+	appState, identities, err := newNetwork(b.groups, b.balance, b.seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Tester{
+		appState:   appState,
+		factory:    b.factory,
+		identities: identities,
+		nonces:     map[common.Address]uint32{},
+		deployed:   map[common.Address]common.Hash{},
+	}, nil
+}
+
+// Tester executes deploy, call and terminate transactions signed by any key in a new env with the current height and timestamp.
+// Changes of the last transaction are kept in its env until Commit, the next transaction discards them.
+type Tester struct {
+	appState   *appstate.AppState
+	factory    ContractFactory
+	identities []*ecdsa.PrivateKey
+	nonces     map[common.Address]uint32
+	deployed   map[common.Address]common.Hash
+	env        *env.EnvImp
+	height     uint64
+	timestamp  int64
+}
+
+func (t *Tester) AppState() *appstate.AppState {
+	return t.appState
+}
+
+func (t *Tester) IdentitiesCount() int {
+	return len(t.identities)
+}
+
+func (t *Tester) Key(index int) (*ecdsa.PrivateKey, error) {
+	if index < 0 || index >= len(t.identities) {
+		return nil, errors.Errorf("identity %v is not found", index)
+	}
+	return t.identities[index], nil
+}
+
+func (t *Tester) Identity(index int) (common.Address, error) {
+	key, err := t.Key(index)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+// Env returns the env of the last transaction
+func (t *Tester) Env() *env.EnvImp {
+	return t.env
+}
+
+func (t *Tester) Height() uint64 {
+	return t.height
+}
+
+func (t *Tester) SetHeight(height uint64) {
+	t.height = height
+}
+
+func (t *Tester) Timestamp() int64 {
+	return t.timestamp
+}
+
+func (t *Tester) SetTimestamp(timestamp int64) {
+	t.timestamp = timestamp
+}
+
+// Deploy deploys the contract, the amount is the contract stake
+func (t *Tester) Deploy(key *ecdsa.PrivateKey, codeHash common.Hash, amount *big.Int, args ...[]byte) (addr common.Address, err error) {
+	attachment := attachments.CreateDeployContractAttachment(codeHash, args...)
+	payload, err := attachment.ToBytes()
+	if err != nil {
+		return common.Address{}, err
+	}
+	tx, err := t.tx(key, types.DeployContractTx, nil, amount, payload)
+	if err != nil {
+		return common.Address{}, err
+	}
+	ctx := env.NewDeployContextImpl(tx, nil, codeHash)
+	addr = ctx.ContractAddr()
+	contract := t.factory(ctx, t.newEnv())
+	if contract == nil {
+		return addr, errors.New("unknown contract")
+	}
+	defer recoverError(&err)
+	if err = contract.Deploy(attachment.Args...); err != nil {
+		return addr, err
+	}
+	t.env.Deploy(ctx)
+	t.deployed[addr] = codeHash
+	return addr, nil
+}
+
+// Call calls the contract method, the amount is the payment available to the contract, it isn't transferred by the tester
+func (t *Tester) Call(key *ecdsa.PrivateKey, contract common.Address, amount *big.Int, method string, args ...[]byte) (err error) {
+	attachment := attachments.CreateCallContractAttachment(method, args...)
+	payload, err := attachment.ToBytes()
+	if err != nil {
+		return err
+	}
+	ctx, err := t.callContext(key, types.CallContractTx, contract, amount, payload)
+	if err != nil {
+		return err
+	}
+	instance := t.factory(ctx, t.newEnv())
+	if instance == nil {
+		return errors.New("unknown contract")
+	}
+	defer recoverError(&err)
+	return instance.Call(attachment.Method, attachment.Args...)
+}
+
+// Terminate terminates the contract and returns the destination of the stake refund
+func (t *Tester) Terminate(key *ecdsa.PrivateKey, contract common.Address, args ...[]byte) (dest common.Address, err error) {
+	attachment := attachments.CreateTerminateContractAttachment(args...)
+	payload, err := attachment.ToBytes()
+	if err != nil {
+		return common.Address{}, err
+	}
+	ctx, err := t.callContext(key, types.TerminateContractTx, contract, nil, payload)
+	if err != nil {
+		return common.Address{}, err
+	}
+	instance := t.factory(ctx, t.newEnv())
+	if instance == nil {
+		return common.Address{}, errors.New("unknown contract")
+	}
+	defer recoverError(&err)
+	dest, keysToSave, err := instance.Terminate(attachment.Args...)
+	if err == nil {
+		t.env.Terminate(ctx, keysToSave, dest)
+	}
+	return dest, err
+}
+
+// Read calls the read method of the contract with the env of the last transaction, so uncommitted changes are visible
+func (t *Tester) Read(contract common.Address, method string, args ...[]byte) (data []byte, err error) {
+	codeHash, err := t.codeHash(contract)
+	if err != nil {
+		return nil, err
+	}
+	e := t.env
+	if e == nil {
+		e = t.newEnv()
+	}
+	instance := t.factory(&env.ReadContextImpl{Contract: contract, Hash: codeHash}, e)
+	if instance == nil {
+		return nil, errors.New("unknown contract")
+	}
+	defer recoverError(&err)
+	return instance.Read(method, args...)
+}
+
+// Commit applies changes of the last transaction to the state and returns its events
+func (t *Tester) Commit() []*types.TxEvent {
+	var events []*types.TxEvent
+	if t.env != nil {
+		events = t.env.Commit()
+	}
+	t.appState.Commit(nil, true)
+	return events
+}
+
+// Revert discards changes of the last transaction
+func (t *Tester) Revert() {
+	if t.env != nil {
+		t.env.Reset()
+	}
+}
+
+func (t *Tester) tx(key *ecdsa.PrivateKey, txType types.TxType, to *common.Address, amount *big.Int, payload []byte) (*types.Transaction, error) {
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	t.nonces[sender]++
+	tx := &types.Transaction{
+		AccountNonce: t.nonces[sender],
+		Epoch:        t.appState.State.Epoch(),
+		Type:         txType,
+		To:           to,
+		Amount:       amount,
+		Payload:      payload,
+	}
+	return types.SignTx(tx, key)
+}
+
+func (t *Tester) callContext(key *ecdsa.PrivateKey, txType types.TxType, contract common.Address, amount *big.Int, payload []byte) (*env.CallContextImpl, error) {
+	codeHash, err := t.codeHash(contract)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := t.tx(key, txType, &contract, amount, payload)
+	if err != nil {
+		return nil, err
+	}
+	return env.NewCallContextImpl(tx, nil, codeHash), nil
+}
+
+// codeHash returns the code hash of the contract, contracts deployed by the tester are known before commit
+func (t *Tester) codeHash(contract common.Address) (common.Hash, error) {
+	if codeHash := t.appState.State.GetCodeHash(contract); codeHash != nil {
+		return *codeHash, nil
+	}
+	if codeHash, ok := t.deployed[contract]; ok {
+		return codeHash, nil
+	}
+	return common.Hash{}, errors.New("destination is not a contract")
+}
+
+func (t *Tester) newEnv() *env.EnvImp {
+	seed := types.Seed{}
+	seed.SetBytes(common.ToBytes(t.height))
+	header := &types.Header{
+		ProposedHeader: &types.ProposedHeader{
+			BlockSeed: seed,
+			Height:    t.height,
+			Time:      t.timestamp,
+		},
+	}
+	gas := new(env.GasCounter)
+	gas.Reset(-1)
+	e := env.NewEnvImp(t.appState, header, gas, nil)
+	e.SetContractFactory(func(ctx env.CallContext) env.NestedContract {
+		if contract := t.factory(ctx, e); contract != nil {
+			return contract
+		}
+		return nil
+	})
+	t.env = e
+	return e
+}
+
+// recoverError converts a panic of the contract to the error like the vm does
+func recoverError(err *error) {
+	if r := recover(); r != nil {
+		*err = errors.New(fmt.Sprint(r))
+	}
+}
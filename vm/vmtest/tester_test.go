@@ -0,0 +1,52 @@
+package vmtest
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/idena-network/idena-go/vm/env"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestTester(t *testing.T) {
+	conf := *config.ConsensusVersions[config.ConsensusV8]
+	conf.EnableUpgrade9 = true
+	var tester *Tester
+	tester, err := NewBuilder(func(ctx env.CallContext, e env.Env) Contract {
+		return vm.NewContract(tester.AppState(), &conf, ctx, e)
+	}).
+		AddIdentities(IdentityGroup{Count: 2, State: state.Verified}).
+		SetBalance(new(big.Int).Mul(common.DnaBase, big.NewInt(10))).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, 2, tester.IdentitiesCount())
+	ownerKey, _ := tester.Key(0)
+	otherKey, _ := tester.Key(1)
+	other, _ := tester.Identity(1)
+	_, err = tester.Identity(2)
+	require.Error(t, err)
+
+	contract, err := tester.Deploy(ownerKey, embedded.FungibleTokenContract, common.DnaBase,
+		[]byte("token"), []byte("TKN"), []byte{0}, big.NewInt(100).Bytes())
+	require.NoError(t, err)
+	tester.Commit()
+	require.Equal(t, common.DnaBase, tester.AppState().State.GetContractStake(contract))
+
+	require.NoError(t, tester.Call(ownerKey, contract, nil, "transfer", other.Bytes(), big.NewInt(40).Bytes()))
+	events := tester.Commit()
+	require.Len(t, events, 1)
+
+	data, err := tester.Read(contract, "balanceOf", other.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(40).Bytes(), data)
+
+	require.Error(t, tester.Call(otherKey, contract, nil, "mint", other.Bytes(), big.NewInt(1).Bytes()))
+	tester.Revert()
+
+	err = tester.Call(otherKey, common.Address{0x1}, nil, "transfer")
+	require.EqualError(t, err, "destination is not a contract")
+}